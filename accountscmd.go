@@ -0,0 +1,255 @@
+package main
+
+import (
+	"bufio"
+	"crypto/x509"
+	"encoding/pem"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/diamondburned/arikawa/discord"
+	"github.com/pkg/errors"
+	"github.com/tadeokondrak/ircdiscord/internal/accounts"
+	"github.com/tadeokondrak/ircdiscord/internal/config"
+	"github.com/tadeokondrak/ircdiscord/internal/vault"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+const accountsUsage = `usage: ircdiscord accounts [flags] <command>
+
+Manages the accounts file while the server isn't running. Tokens and
+passwords are read from standard input, and never printed.
+
+commands:
+  list                                  list the accounts
+  add [-guild id] [-cert file] <name>   add an account
+  remove <name>                         remove an account
+  rotate [-new-secret file]             encrypt the tokens with a new secret
+
+flags:
+`
+
+// openAccounts opens the accounts file of cfg with its secret.
+func openAccounts(cfg *config.Config) (*accounts.Store, error) {
+	secret, err := vault.ReadSecret(cfg.SecretFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read secret")
+	}
+
+	return accounts.Open(cfg.Accounts, secret)
+}
+
+// runAccounts runs the accounts subcommand.
+func runAccounts(args []string) error {
+	var configFile, accountsFile, secretFile string
+
+	flags := flag.NewFlagSet("accounts", flag.ContinueOnError)
+	flags.StringVar(&configFile, "config", "", "configuration file")
+	flags.StringVar(&accountsFile, "accounts", "", "accounts file")
+	flags.StringVar(&secretFile, "secret", "",
+		"file of the secret that encrypts the tokens, or "+
+			"$"+vault.PassphraseEnv)
+	flags.Usage = func() {
+		fmt.Fprint(flags.Output(), accountsUsage)
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	cfg := config.Default()
+	if configFile != "" {
+		var err error
+		cfg, err = config.Load(configFile)
+		if err != nil {
+			return err
+		}
+	}
+	if accountsFile != "" {
+		cfg.Accounts = accountsFile
+	}
+	if secretFile != "" {
+		cfg.SecretFile = secretFile
+	}
+	if cfg.Accounts == "" {
+		return errors.New("no accounts file given")
+	}
+
+	if flags.NArg() == 0 {
+		flags.Usage()
+		return flag.ErrHelp
+	}
+
+	store, err := openAccounts(cfg)
+	if err != nil {
+		return err
+	}
+
+	stdin := bufio.NewReader(os.Stdin)
+	args = flags.Args()[1:]
+
+	switch flags.Arg(0) {
+	case "list":
+		return listAccounts(store)
+	case "add":
+		return addAccount(store, stdin, args)
+	case "remove":
+		if len(args) != 1 {
+			return errors.New("usage: remove <name>")
+		}
+		return store.Remove(args[0])
+	case "rotate":
+		return rotateSecret(store, stdin, args)
+	default:
+		return errors.Errorf("unknown command %s", flags.Arg(0))
+	}
+}
+
+func listAccounts(store *accounts.Store) error {
+	for _, a := range store.List() {
+		where := "DMs"
+		if a.Guild.Valid() {
+			where = "guild " + a.Guild.String()
+		}
+		fmt.Printf("%s\t%s\t%d certificates\n",
+			a.Name, where, len(a.Fingerprints))
+	}
+	return nil
+}
+
+func addAccount(store *accounts.Store, stdin *bufio.Reader,
+	args []string) error {
+	var guild, certfile string
+
+	flags := flag.NewFlagSet("add", flag.ContinueOnError)
+	flags.StringVar(&guild, "guild", "",
+		"Discord server to log in to by default, DMs if empty")
+	flags.StringVar(&certfile, "cert", "",
+		"client certificate that logs in with SASL EXTERNAL")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		return errors.New("usage: add [-guild id] [-cert file] <name>")
+	}
+
+	account := &accounts.Account{Name: flags.Arg(0)}
+
+	if guild != "" {
+		id, err := discord.ParseSnowflake(guild)
+		if err != nil {
+			return errors.Errorf("invalid guild ID %s", guild)
+		}
+		account.Guild = id
+	}
+
+	if certfile != "" {
+		cert, err := readCertificate(certfile)
+		if err != nil {
+			return err
+		}
+		account.Fingerprints = []string{accounts.Fingerprint(cert)}
+	}
+
+	token, err := prompt(stdin, "Discord token: ")
+	if err != nil {
+		return err
+	}
+	if token == "" {
+		return errors.New("a token is required")
+	}
+	account.Token = token
+
+	password, err := prompt(stdin, "Password (empty for none): ")
+	if err != nil {
+		return err
+	}
+	if password != "" {
+		if err := account.SetPassword(password); err != nil {
+			return err
+		}
+	} else if certfile == "" {
+		return errors.New("a password or a certificate is required")
+	}
+
+	return store.Add(account)
+}
+
+func rotateSecret(store *accounts.Store, stdin *bufio.Reader,
+	args []string) error {
+	var newSecretFile string
+
+	flags := flag.NewFlagSet("rotate", flag.ContinueOnError)
+	flags.StringVar(&newSecretFile, "new-secret", "",
+		"file of the new secret, read from standard input if empty")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	var secret []byte
+	if newSecretFile != "" {
+		var err error
+		secret, err = vault.ReadSecret(newSecretFile)
+		if err != nil {
+			return err
+		}
+	} else {
+		passphrase, err := prompt(stdin, "New passphrase: ")
+		if err != nil {
+			return err
+		}
+		again, err := prompt(stdin, "Repeat it: ")
+		if err != nil {
+			return err
+		}
+		if passphrase != again {
+			return errors.New("the passphrases don't match")
+		}
+		secret = []byte(passphrase)
+	}
+
+	if err := store.Rotate(secret); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(os.Stderr, "Tokens are now encrypted with the new secret, "+
+		"which must be given to the server from now on.")
+
+	return nil
+}
+
+// prompt reads a line from the terminal without echoing it, or from standard
+// input if it isn't a terminal.
+func prompt(stdin *bufio.Reader, what string) (string, error) {
+	fd := int(os.Stdin.Fd())
+	if terminal.IsTerminal(fd) {
+		fmt.Fprint(os.Stderr, what)
+		line, err := terminal.ReadPassword(fd)
+		fmt.Fprintln(os.Stderr)
+		return string(line), err
+	}
+
+	line, err := stdin.ReadString('\n')
+	if err != nil && !(err == io.EOF && line != "") {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+func readCertificate(name string) (*x509.Certificate, error) {
+	data, err := ioutil.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, errors.Errorf("%s: no PEM certificate found", name)
+	}
+
+	return x509.ParseCertificate(block.Bytes)
+}
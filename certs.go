@@ -0,0 +1,153 @@
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/tadeokondrak/ircdiscord/internal/logging"
+)
+
+// certInterval is how often the certificate files are checked for changes.
+const certInterval = time.Minute
+
+// certStore holds the certificate used by TLS listeners, and reloads it when
+// asked to or when its files change, so renewing it doesn't need a restart.
+type certStore struct {
+	certfile string
+	keyfile  string
+	log      *logging.Logger
+
+	mu       sync.RWMutex // guards next 2 fields
+	cert     *tls.Certificate
+	modTimes [2]time.Time // of the files as last loaded
+}
+
+// newCertStore loads the certificate in certfile and keyfile.
+func newCertStore(certfile, keyfile string,
+	log *logging.Logger) (*certStore, error) {
+	if certfile == "" || keyfile == "" {
+		return nil, errors.New(
+			"certfile and keyfile are required for TLS")
+	}
+
+	c := &certStore{certfile: certfile, keyfile: keyfile, log: log}
+	if err := c.reload(); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// tlsConfig returns a configuration which always uses the current
+// certificate. Client certificates are asked for but not verified, as they
+// are only matched against the fingerprints of accounts.
+func (c *certStore) tlsConfig() *tls.Config {
+	return &tls.Config{
+		GetCertificate: c.getCertificate,
+		ClientAuth:     tls.RequestClientCert,
+	}
+}
+
+func (c *certStore) getCertificate(
+	*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.cert, nil
+}
+
+// fileModTimes returns the modification times of the certificate files.
+func (c *certStore) fileModTimes() ([2]time.Time, error) {
+	var times [2]time.Time
+	for i, name := range []string{c.certfile, c.keyfile} {
+		info, err := os.Stat(name)
+		if err != nil {
+			return times, err
+		}
+		times[i] = info.ModTime()
+	}
+	return times, nil
+}
+
+// reload loads the certificate files again. The current certificate is kept
+// if they don't hold a valid pair.
+func (c *certStore) reload() error {
+	modTimes, err := c.fileModTimes()
+	if err != nil {
+		return errors.Wrap(err, "failed to load keypair")
+	}
+
+	// recorded even if loading fails, so a broken pair is only retried
+	// once the files change again
+	c.mu.Lock()
+	c.modTimes = modTimes
+	c.mu.Unlock()
+
+	cert, err := tls.LoadX509KeyPair(c.certfile, c.keyfile)
+	if err != nil {
+		return errors.Wrap(err, "failed to load keypair")
+	}
+
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		return errors.Wrap(err, "failed to parse certificate")
+	}
+	now := time.Now()
+	if now.After(leaf.NotAfter) {
+		return errors.Errorf("certificate %s expired on %v",
+			c.certfile, leaf.NotAfter)
+	}
+	if now.Before(leaf.NotBefore) {
+		return errors.Errorf("certificate %s is not valid until %v",
+			c.certfile, leaf.NotBefore)
+	}
+	cert.Leaf = leaf
+
+	c.mu.Lock()
+	c.cert = &cert
+	c.mu.Unlock()
+
+	c.log.With("file", c.certfile).
+		Infof("loaded certificate, valid until %v", leaf.NotAfter)
+
+	return nil
+}
+
+// changed returns whether the certificate files were modified since they were
+// last loaded.
+func (c *certStore) changed() bool {
+	modTimes, err := c.fileModTimes()
+	if err != nil {
+		// probably in the middle of being replaced
+		return false
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return modTimes != c.modTimes
+}
+
+// watch reloads the certificate whenever its files change, until stop is
+// closed. A nil stop channel watches forever.
+func (c *certStore) watch(interval time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if !c.changed() {
+				continue
+			}
+			if err := c.reload(); err != nil {
+				c.log.With("error", err).
+					Warnf("keeping the current certificate")
+			}
+		case <-stop:
+			return
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tadeokondrak/ircdiscord/internal/logging"
+)
+
+// writeCert writes a self-signed certificate valid until notAfter.
+func writeCert(t *testing.T, certfile, keyfile string, notAfter time.Time) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		NotBefore:    notAfter.Add(-48 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template,
+		&key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := ioutil.WriteFile(certfile, pem.EncodeToMemory(
+		&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(keyfile, pem.EncodeToMemory(
+		&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCertStoreReload(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ircdiscord")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	certfile := filepath.Join(dir, "cert.pem")
+	keyfile := filepath.Join(dir, "key.pem")
+	first := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+	writeCert(t, certfile, keyfile, first)
+
+	certs, err := newCertStore(certfile, keyfile, logging.Discard())
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.False(t, certs.changed())
+
+	notAfter := func() time.Time {
+		cert, err := certs.getCertificate(nil)
+		assert.NoError(t, err)
+		return cert.Leaf.NotAfter
+	}
+	assert.True(t, first.Equal(notAfter()))
+
+	// a renewed certificate is swapped in
+	second := first.Add(24 * time.Hour)
+	writeCert(t, certfile, keyfile, second)
+	assert.NoError(t, certs.reload())
+	assert.True(t, second.Equal(notAfter()))
+
+	// a broken key is refused
+	if err := ioutil.WriteFile(keyfile, []byte("garbage"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	assert.Error(t, certs.reload())
+	assert.True(t, second.Equal(notAfter()))
+
+	// and so is an expired certificate
+	writeCert(t, certfile, keyfile, time.Now().Add(-time.Hour))
+	assert.Error(t, certs.reload())
+	assert.True(t, second.Equal(notAfter()))
+}
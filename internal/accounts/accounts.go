@@ -0,0 +1,336 @@
+// Package accounts stores the local accounts IRC clients log in to with SASL,
+// each holding the Discord token used on the account's behalf. Tokens are
+// encrypted in the file with a vault.Vault.
+package accounts
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/diamondburned/arikawa/discord"
+	"github.com/tadeokondrak/ircdiscord/internal/vault"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Account is a local account.
+type Account struct {
+	Name         string            `json:"name"`
+	PasswordHash []byte            `json:"password_hash,omitempty"`
+	Token        string            `json:"-"`               // sealed in the file
+	Guild        discord.Snowflake `json:"guild,omitempty"` // 0 for DMs
+	// Fingerprints holds the SHA-256 fingerprints of the client
+	// certificates that log in to the account with SASL EXTERNAL.
+	Fingerprints []string `json:"fingerprints,omitempty"`
+}
+
+// SetPassword sets the password of the account.
+func (a *Account) SetPassword(password string) error {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password),
+		bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+	a.PasswordHash = hash
+	return nil
+}
+
+// Fingerprint returns the fingerprint of a client certificate, as stored in
+// Account.Fingerprints.
+func Fingerprint(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.Raw)
+	return hex.EncodeToString(sum[:])
+}
+
+// ErrAuthFailed is returned when logging in fails, without saying why.
+var ErrAuthFailed = fmt.Errorf("invalid credentials")
+
+// file is the format of the accounts file.
+type file struct {
+	KDF      *vault.Params    `json:"kdf"`
+	Accounts []*storedAccount `json:"accounts"`
+}
+
+type storedAccount struct {
+	Account
+	SealedToken string `json:"sealed_token"`
+}
+
+// tokenContext binds a sealed token to its account.
+func tokenContext(name string) string {
+	return "token:" + name
+}
+
+// Store is a set of accounts kept in a file.
+type Store struct {
+	path string
+
+	mu       sync.RWMutex // guards next 3 fields
+	params   *vault.Params
+	vault    *vault.Vault
+	accounts map[string]*Account
+}
+
+// Open reads the accounts in the file at path, decrypting their tokens with
+// a key derived from secret. A missing file is created when the first
+// account is added.
+func Open(path string, secret []byte) (*Store, error) {
+	s := &Store{path: path, accounts: make(map[string]*Account)}
+
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		if err := s.setSecret(secret); err != nil {
+			return nil, err
+		}
+		return s, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var f file
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	if f.KDF == nil {
+		return nil, fmt.Errorf("%s: missing key derivation parameters",
+			path)
+	}
+
+	s.params = f.KDF
+	s.vault, err = vault.New(secret, f.KDF)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, stored := range f.Accounts {
+		token, err := s.vault.Open(stored.SealedToken,
+			tokenContext(stored.Name))
+		if err != nil {
+			return nil, fmt.Errorf("%s: token of %s: %v",
+				path, stored.Name, err)
+		}
+		a := stored.Account
+		a.Token = string(token)
+		s.accounts[a.Name] = &a
+	}
+
+	return s, nil
+}
+
+// setSecret derives a new key from secret, with a new salt.
+// The caller must hold s.mu if the store is shared.
+func (s *Store) setSecret(secret []byte) error {
+	params, err := vault.NewParams()
+	if err != nil {
+		return err
+	}
+	v, err := vault.New(secret, params)
+	if err != nil {
+		return err
+	}
+	s.params = params
+	s.vault = v
+	return nil
+}
+
+// Rotate encrypts the tokens again with a key derived from a new secret.
+func (s *Store) Rotate(secret []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	params, v := s.params, s.vault
+	if err := s.setSecret(secret); err != nil {
+		return err
+	}
+
+	if err := s.save(); err != nil {
+		s.params, s.vault = params, v
+		return err
+	}
+
+	return nil
+}
+
+// save writes the accounts to the file, replacing it at once so a crash
+// can't leave it half written.
+func (s *Store) save() error {
+	f := file{KDF: s.params, Accounts: []*storedAccount{}}
+	for _, a := range s.accounts {
+		sealed, err := s.vault.Seal([]byte(a.Token), tokenContext(a.Name))
+		if err != nil {
+			return err
+		}
+		f.Accounts = append(f.Accounts,
+			&storedAccount{Account: *a, SealedToken: sealed})
+	}
+	sort.Slice(f.Accounts, func(i, j int) bool {
+		return f.Accounts[i].Name < f.Accounts[j].Name
+	})
+
+	data, err := json.MarshalIndent(&f, "", "\t")
+	if err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Dir(s.path),
+		"."+filepath.Base(s.path))
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	// password hashes are in there too, so only the owner may read it
+	if err := tmp.Chmod(0600); err != nil {
+		tmp.Close()
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), s.path)
+}
+
+// ValidName returns whether name can be used for an account. Names can't
+// contain slashes, which separate them from guild IDs when logging in.
+func ValidName(name string) bool {
+	return name != "" && !strings.ContainsAny(name, "/ \x00")
+}
+
+// Add adds a new account.
+func (s *Store) Add(a *Account) error {
+	if !ValidName(a.Name) {
+		return fmt.Errorf("invalid account name %q", a.Name)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.accounts[a.Name]; ok {
+		return fmt.Errorf("account %s already exists", a.Name)
+	}
+	for _, fingerprint := range a.Fingerprints {
+		if other := s.byFingerprint(fingerprint); len(other) > 0 {
+			return fmt.Errorf("certificate already belongs to account %s",
+				other[0].Name)
+		}
+	}
+	s.accounts[a.Name] = a
+
+	if err := s.save(); err != nil {
+		delete(s.accounts, a.Name)
+		return err
+	}
+
+	return nil
+}
+
+// Remove removes an account.
+func (s *Store) Remove(name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	a, ok := s.accounts[name]
+	if !ok {
+		return fmt.Errorf("no account named %s", name)
+	}
+	delete(s.accounts, name)
+
+	if err := s.save(); err != nil {
+		s.accounts[name] = a
+		return err
+	}
+
+	return nil
+}
+
+// List returns copies of all accounts sorted by name, without their tokens
+// and password hashes.
+func (s *Store) List() []Account {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	accounts := make([]Account, 0, len(s.accounts))
+	for _, a := range s.accounts {
+		copy := *a
+		copy.Token = ""
+		copy.PasswordHash = nil
+		accounts = append(accounts, copy)
+	}
+	sort.Slice(accounts, func(i, j int) bool {
+		return accounts[i].Name < accounts[j].Name
+	})
+
+	return accounts
+}
+
+// Authenticate returns the account name logs in to with password.
+func (s *Store) Authenticate(name, password string) (*Account, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	a, ok := s.accounts[name]
+	if !ok || a.PasswordHash == nil {
+		return nil, ErrAuthFailed
+	}
+	if err := bcrypt.CompareHashAndPassword(a.PasswordHash,
+		[]byte(password)); err != nil {
+		return nil, ErrAuthFailed
+	}
+
+	copy := *a
+	return &copy, nil
+}
+
+// byFingerprint returns the accounts a certificate fingerprint belongs to.
+func (s *Store) byFingerprint(fingerprint string) []*Account {
+	var accounts []*Account
+	for _, a := range s.accounts {
+		for _, other := range a.Fingerprints {
+			if strings.EqualFold(fingerprint, other) {
+				accounts = append(accounts, a)
+				break
+			}
+		}
+	}
+	return accounts
+}
+
+// AuthenticateCertificate returns the account a client certificate logs in
+// to. If name isn't empty, the certificate must belong to that account;
+// otherwise it must belong to only one.
+func (s *Store) AuthenticateCertificate(cert *x509.Certificate,
+	name string) (*Account, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var found *Account
+	for _, a := range s.byFingerprint(Fingerprint(cert)) {
+		if name != "" && a.Name != name {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf(
+				"certificate belongs to several accounts, give one")
+		}
+		found = a
+	}
+	if found == nil {
+		return nil, ErrAuthFailed
+	}
+
+	copy := *found
+	return &copy, nil
+}
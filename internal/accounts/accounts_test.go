@@ -0,0 +1,131 @@
+package accounts
+
+import (
+	"crypto/x509"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ircdiscord")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "accounts.json")
+
+	secret := []byte("secret")
+	store, err := Open(path, secret)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	cert := &x509.Certificate{Raw: []byte("certificate")}
+	account := &Account{
+		Name:         "alice",
+		Token:        "discord-token",
+		Guild:        1,
+		Fingerprints: []string{Fingerprint(cert)},
+	}
+	assert.NoError(t, account.SetPassword("hunter2"))
+	assert.NoError(t, store.Add(account))
+	assert.Error(t, store.Add(&Account{Name: "alice"}))
+	assert.Error(t, store.Add(&Account{Name: "a/b"}))
+	assert.Error(t, store.Add(&Account{
+		Name:         "bob",
+		Fingerprints: []string{Fingerprint(cert)},
+	}))
+
+	info, err := os.Stat(path)
+	if assert.NoError(t, err) {
+		assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+	}
+
+	// tokens are not stored in plain text
+	data, err := ioutil.ReadFile(path)
+	if assert.NoError(t, err) {
+		assert.NotContains(t, string(data), "discord-token")
+	}
+
+	// accounts survive reopening the file, but only with the secret
+	_, err = Open(path, []byte("wrong"))
+	assert.Error(t, err)
+	store, err = Open(path, secret)
+	if !assert.NoError(t, err) {
+		return
+	}
+	if list := store.List(); assert.Len(t, list, 1) {
+		assert.Equal(t, "alice", list[0].Name)
+		assert.Empty(t, list[0].Token)
+		assert.Empty(t, list[0].PasswordHash)
+	}
+
+	got, err := store.Authenticate("alice", "hunter2")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "discord-token", got.Token)
+	}
+	_, err = store.Authenticate("alice", "hunter3")
+	assert.Equal(t, ErrAuthFailed, err)
+	_, err = store.Authenticate("bob", "hunter2")
+	assert.Equal(t, ErrAuthFailed, err)
+
+	got, err = store.AuthenticateCertificate(cert, "")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "alice", got.Name)
+	}
+	_, err = store.AuthenticateCertificate(cert, "bob")
+	assert.Equal(t, ErrAuthFailed, err)
+	_, err = store.AuthenticateCertificate(
+		&x509.Certificate{Raw: []byte("other")}, "")
+	assert.Equal(t, ErrAuthFailed, err)
+
+	// rotating the secret keeps the accounts
+	newSecret := []byte("new secret")
+	assert.NoError(t, store.Rotate(newSecret))
+	_, err = Open(path, secret)
+	assert.Error(t, err)
+	store, err = Open(path, newSecret)
+	if !assert.NoError(t, err) {
+		return
+	}
+	got, err = store.Authenticate("alice", "hunter2")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "discord-token", got.Token)
+	}
+
+	assert.NoError(t, store.Remove("alice"))
+	assert.Error(t, store.Remove("alice"))
+	assert.Empty(t, store.List())
+}
+
+func TestSharedCertificate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ircdiscord")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	store, err := Open(filepath.Join(dir, "accounts.json"), []byte("secret"))
+	if !assert.NoError(t, err) {
+		return
+	}
+	cert := &x509.Certificate{Raw: []byte("certificate")}
+	fingerprints := []string{Fingerprint(cert)}
+	assert.NoError(t, store.Add(&Account{Name: "alice",
+		Fingerprints: fingerprints}))
+	assert.NoError(t, store.Add(&Account{Name: "bob"}))
+
+	// a hand-edited file can give two accounts the same certificate
+	store.accounts["bob"].Fingerprints = fingerprints
+
+	_, err = store.AuthenticateCertificate(cert, "")
+	assert.Error(t, err)
+	got, err := store.AuthenticateCertificate(cert, "bob")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "bob", got.Name)
+	}
+}
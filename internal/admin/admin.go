@@ -0,0 +1,217 @@
+// Package admin serves an HTTP API for operators, with JSON requests and
+// responses:
+//
+//	GET    /clients          connected clients
+//	DELETE /clients/<id>     disconnect a client
+//	GET    /sessions         Discord sessions and how many clients use them
+//	DELETE /sessions/<user>  close the session of a Discord user
+//	POST   /notice           send {"message": "..."} to all clients
+//
+// It has no authentication of its own, and is meant to be served on a Unix
+// socket, which is recommended as file permissions decide who may use it, or
+// on localhost. Requests must be addressed to localhost, and POSTs must be
+// JSON, so web pages can't make browsers send requests to it.
+package admin
+
+import (
+	"encoding/json"
+	"mime"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/diamondburned/arikawa/discord"
+	"github.com/tadeokondrak/ircdiscord/internal/logging"
+	"github.com/tadeokondrak/ircdiscord/internal/server"
+)
+
+// Reasons given to clients disconnected by operators.
+const (
+	disconnectReason   = "disconnected by an operator"
+	closeSessionReason = "Discord session closed by an operator"
+)
+
+type clientInfo struct {
+	ID    uint64            `json:"id"`
+	Addr  string            `json:"addr"`
+	User  discord.Snowflake `json:"user,omitempty"`
+	Guild discord.Snowflake `json:"guild,omitempty"`
+}
+
+type sessionInfo struct {
+	User discord.Snowflake `json:"user"`
+	Refs int               `json:"refs"`
+}
+
+type notice struct {
+	Message string `json:"message"`
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+type handler struct {
+	server *server.Server
+	log    *logging.Logger
+}
+
+// Handler returns a handler serving the API for s.
+func Handler(s *server.Server, log *logging.Logger) http.Handler {
+	h := &handler{server: s, log: log}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/clients", h.clients)
+	mux.HandleFunc("/clients/", h.client)
+	mux.HandleFunc("/sessions", h.sessions)
+	mux.HandleFunc("/sessions/", h.session)
+	mux.HandleFunc("/notice", h.notice)
+	return checkRequest(mux)
+}
+
+// checkRequest rejects requests a browser could send for another site. A
+// Host other than localhost means a page rebound its name to this address,
+// and only forms and text can be POSTed without the browser asking first.
+func checkRequest(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host := r.Host
+		if h, _, err := net.SplitHostPort(host); err == nil {
+			host = h
+		}
+		switch host {
+		case "", "localhost", "127.0.0.1", "[::1]", "::1":
+		default:
+			replyError(w, http.StatusForbidden, "invalid host")
+			return
+		}
+
+		if r.Method == http.MethodPost {
+			mediaType, _, err := mime.ParseMediaType(
+				r.Header.Get("Content-Type"))
+			if err != nil || mediaType != "application/json" {
+				replyError(w, http.StatusUnsupportedMediaType,
+					"expected application/json")
+				return
+			}
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (h *handler) clients(w http.ResponseWriter, r *http.Request) {
+	if !allow(w, r, http.MethodGet) {
+		return
+	}
+
+	clients := []clientInfo{}
+	for _, info := range h.server.Clients() {
+		c := clientInfo{ID: info.ID, User: info.User, Guild: info.Guild}
+		if info.Addr != nil {
+			c.Addr = info.Addr.String()
+		}
+		clients = append(clients, c)
+	}
+
+	reply(w, http.StatusOK, clients)
+}
+
+func (h *handler) client(w http.ResponseWriter, r *http.Request) {
+	if !allow(w, r, http.MethodDelete) {
+		return
+	}
+
+	id, err := strconv.ParseUint(
+		strings.TrimPrefix(r.URL.Path, "/clients/"), 10, 64)
+	if err != nil {
+		replyError(w, http.StatusNotFound, "invalid client ID")
+		return
+	}
+
+	if err := h.server.Disconnect(id, disconnectReason); err != nil {
+		replyError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	h.log.With("client", id).Infof("disconnected client")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *handler) sessions(w http.ResponseWriter, r *http.Request) {
+	if !allow(w, r, http.MethodGet) {
+		return
+	}
+
+	sessions := []sessionInfo{}
+	for _, info := range h.server.Sessions() {
+		sessions = append(sessions,
+			sessionInfo{User: info.User, Refs: info.Refs})
+	}
+
+	reply(w, http.StatusOK, sessions)
+}
+
+func (h *handler) session(w http.ResponseWriter, r *http.Request) {
+	if !allow(w, r, http.MethodDelete) {
+		return
+	}
+
+	user, err := discord.ParseSnowflake(
+		strings.TrimPrefix(r.URL.Path, "/sessions/"))
+	if err != nil {
+		replyError(w, http.StatusNotFound, "invalid user ID")
+		return
+	}
+
+	if err := h.server.CloseSession(user,
+		closeSessionReason); err != nil {
+		replyError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	h.log.With("user", user).Infof("closed session")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *handler) notice(w http.ResponseWriter, r *http.Request) {
+	if !allow(w, r, http.MethodPost) {
+		return
+	}
+
+	var n notice
+	if err := json.NewDecoder(r.Body).Decode(&n); err != nil {
+		replyError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if n.Message == "" {
+		replyError(w, http.StatusBadRequest, "empty message")
+		return
+	}
+
+	sent := h.server.Broadcast(n.Message)
+	h.log.Infof("sent notice to %d clients", sent)
+	reply(w, http.StatusOK, struct {
+		Clients int `json:"clients"`
+	}{sent})
+}
+
+// allow replies with an error unless the request uses method.
+func allow(w http.ResponseWriter, r *http.Request, method string) bool {
+	if r.Method != method {
+		w.Header().Set("Allow", method)
+		replyError(w, http.StatusMethodNotAllowed,
+			"method not allowed")
+		return false
+	}
+	return true
+}
+
+func reply(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func replyError(w http.ResponseWriter, status int, message string) {
+	reply(w, status, errorResponse{Error: message})
+}
@@ -0,0 +1,79 @@
+package admin
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tadeokondrak/ircdiscord/internal/client"
+	"github.com/tadeokondrak/ircdiscord/internal/logging"
+	"github.com/tadeokondrak/ircdiscord/internal/server"
+)
+
+func TestHandler(t *testing.T) {
+	s := server.New(nil, &client.Config{}, logging.Discard())
+	h := Handler(s, logging.Discard())
+
+	for _, test := range []struct {
+		method, path, body string
+		status             int
+		response           string
+	}{
+		{"GET", "/clients", "", 200, "[]\n"},
+		{"GET", "/sessions", "", 200, "[]\n"},
+		{"POST", "/clients", "", 405,
+			`{"error":"method not allowed"}` + "\n"},
+		{"DELETE", "/clients/3", "", 404,
+			`{"error":"no client with ID 3"}` + "\n"},
+		{"DELETE", "/clients/me", "", 404,
+			`{"error":"invalid client ID"}` + "\n"},
+		{"DELETE", "/sessions/80351110224678912", "", 404,
+			`{"error":"no session for user 80351110224678912"}` + "\n"},
+		{"POST", "/notice", `{"message":"restarting soon"}`, 200,
+			`{"clients":0}` + "\n"},
+		{"POST", "/notice", `{}`, 400, `{"error":"empty message"}` + "\n"},
+	} {
+		r := httptest.NewRequest(test.method,
+			"http://localhost"+test.path, strings.NewReader(test.body))
+		r.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+
+		name := test.method + " " + test.path
+		assert.Equal(t, test.status, w.Code, name)
+		assert.Equal(t, test.response, w.Body.String(), name)
+		assert.Equal(t, "application/json",
+			w.Header().Get("Content-Type"), name)
+	}
+}
+
+func TestCrossSite(t *testing.T) {
+	s := server.New(nil, &client.Config{}, logging.Discard())
+	h := Handler(s, logging.Discard())
+
+	// a rebound name
+	r := httptest.NewRequest("GET", "http://evil.example:6000/clients", nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	assert.Equal(t, 403, w.Code)
+
+	// a form posted by a page
+	for _, contentType := range []string{
+		"application/x-www-form-urlencoded", "text/plain", "",
+	} {
+		r = httptest.NewRequest("POST", "http://127.0.0.1:6000/notice",
+			strings.NewReader(`{"message":"hi"}`))
+		r.Header.Set("Content-Type", contentType)
+		w = httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		assert.Equal(t, 415, w.Code, contentType)
+	}
+
+	r = httptest.NewRequest("POST", "http://[::1]:6000/notice",
+		strings.NewReader(`{"message":"hi"}`))
+	r.Header.Set("Content-Type", "application/json; charset=utf-8")
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	assert.Equal(t, 200, w.Code)
+}
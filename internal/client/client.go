@@ -1,20 +1,48 @@
 package client
 
 import (
+	"fmt"
 	"io"
-	"log"
 	"net"
+	"regexp"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/diamondburned/arikawa/discord"
+	"github.com/tadeokondrak/ircdiscord/internal/accounts"
 	"github.com/tadeokondrak/ircdiscord/internal/ilayer"
+	"github.com/tadeokondrak/ircdiscord/internal/logging"
+	"github.com/tadeokondrak/ircdiscord/internal/redact"
+	"github.com/tadeokondrak/ircdiscord/internal/render"
 	"github.com/tadeokondrak/ircdiscord/internal/replies"
 	"github.com/tadeokondrak/ircdiscord/internal/session"
 	"gopkg.in/irc.v3"
 )
 
-type SessionFunc func(token string, debug bool) (*session.Session, error)
+type SessionFunc func(token string) (*session.Session, error)
+
+// Config holds the settings clients are created with.
+type Config struct {
+	ServerName    string
+	ServerVersion string
+	MOTD          []string
+	Render        *render.Options // copied for each client
+	Uploads       *UploadOptions
+	Accounts      *accounts.Store // nil if SASL is disabled
+	PassLogin     bool            // whether PASS can hold a Discord token
+	Backlog       int             // messages shown when joining a channel
+	// Users holds settings for particular Discord users, which replace the
+	// ones above once they log in.
+	Users map[discord.Snowflake]*UserConfig
+}
+
+// UserConfig holds the settings for a Discord user.
+type UserConfig struct {
+	Render  *render.Options
+	Backlog int
+}
 
 type Client struct {
 	sessionFunc   SessionFunc
@@ -24,51 +52,101 @@ type Client struct {
 	session       *session.Session  // nil pre-login
 	guild         discord.Snowflake // invalid for DM server and pre-login
 	lastMessageID discord.Snowflake // used to prevent duplicate messages
+	contents      *contentCache     // used to detect and diff edits
+	lastChannel   string            // where files sent over DCC go
 	capabilities  map[string]bool   // ircv3 capabilities
-	debug         bool
-	discordDebug  bool       // whether to log Discord interaction
-	errors        chan error // send errors here from goroutines
+	config        *Config
+	render        *render.Options
+	backlog       int
+	logger        *logging.Logger   // with the client's address
+	log           *logging.Logger   // also with its user once logged in
+	errors        chan error        // send errors here from goroutines
+	callbacks     chan func() error // run on the client's goroutine
+	uploads       int               // uploads in progress
+	queued        int32             // Discord events waiting to be written
+	dropped       int32             // queued events left when shutting down
+	shutdown      chan shutdown     // asks the client to disconnect
+	done          chan struct{}     // closed when Run returns
 	cancels       []func()
+
+	infoMu sync.Mutex // guards info
+	info   Info
 }
 
-func New(conn net.Conn, sessionFunc SessionFunc,
-	debug, ircDebug, discordDebug bool) *Client {
-	log.Printf("creating client %v", conn.RemoteAddr())
+// Info describes a client to operators.
+type Info struct {
+	Addr  net.Addr
+	User  discord.Snowflake // invalid before logging in
+	Guild discord.Snowflake // invalid for DMs
+}
+
+// shutdown is a request to disconnect a client.
+type shutdown struct {
+	reason   string
+	deadline time.Time // for finishing uploads
+}
+
+func New(conn net.Conn, sessionFunc SessionFunc, config *Config,
+	logger *logging.Logger) *Client {
+	logger = logger.With("addr", conn.RemoteAddr())
+	log := logger.Subsystem("client")
+	log.Infof("creating client")
 
 	ircconn := irc.NewConn(conn)
 	client := ilayer.NewClient(ircconn,
 		conn.LocalAddr().String(), conn.RemoteAddr().String())
 
+	opts := *config.Render
 	c := &Client{
 		sessionFunc:  sessionFunc,
 		netconn:      conn,
 		ircconn:      ircconn,
 		ilayer:       client,
+		contents:     newContentCache(),
 		capabilities: make(map[string]bool),
-		debug:        debug,
-		discordDebug: discordDebug,
+		config:       config,
+		render:       &opts,
+		backlog:      config.Backlog,
+		logger:       logger,
+		log:          log,
 		errors:       make(chan error),
+		callbacks:    make(chan func() error),
+		shutdown:     make(chan shutdown, 1),
+		done:         make(chan struct{}),
+		info:         Info{Addr: conn.RemoteAddr()},
 	}
 
 	c.ilayer.Server = c
 
-	if ircDebug {
+	if ircLog := logger.Subsystem("irc"); ircLog.Enabled(logging.Debug) {
 		c.ircconn.Reader.DebugCallback = func(line string) {
-			log.Printf("<-i %s", line)
+			ircLog.Debugf("<- %s", debugLine(line))
 		}
 
 		c.ircconn.Writer.DebugCallback = func(line string) {
-			log.Printf("->i %s", line)
+			ircLog.Debugf("-> %s", debugLine(line))
 		}
 	}
 
 	return c
 }
 
+// registerRegex matches the register service command, up to the password.
+var registerRegex = regexp.MustCompile(
+	`(?i)^((?:@\S+ +)?(?::\S+ +)?PRIVMSG +` + serviceName + ` +:?register +\S+ +).*`)
+
+// debugLine masks the secrets in a raw IRC line so it can be logged,
+// including the password given to the register service command, and drops
+// its line ending.
+func debugLine(line string) string {
+	line = strings.TrimRight(line, "\r\n")
+	line = registerRegex.ReplaceAllString(line, "${1}"+redact.Mask)
+	return redact.IRCLine(line)
+}
+
 func (c *Client) Close() error {
-	if c.debug {
-		log.Printf("closing client %v", c.netconn.RemoteAddr())
-	}
+	// c.log is only used on the client's goroutine
+	c.logger.Subsystem("client").Debugf("closing client")
 
 	for _, cancel := range c.cancels {
 		cancel()
@@ -81,6 +159,103 @@ func (c *Client) Close() error {
 	return c.netconn.Close()
 }
 
+// Shutdown asks the client to disconnect, telling the IRC client the reason.
+// Uploads in progress are given until deadline to finish. It may be called
+// from any goroutine, and doesn't wait for the client to disconnect.
+func (c *Client) Shutdown(reason string, deadline time.Time) {
+	select {
+	case c.shutdown <- shutdown{reason: reason, deadline: deadline}:
+	default:
+		// already shutting down
+	}
+}
+
+// RemoteAddr returns the address of the IRC client.
+func (c *Client) RemoteAddr() net.Addr {
+	return c.netconn.RemoteAddr()
+}
+
+// Info returns a description of the client. It may be called from any
+// goroutine.
+func (c *Client) Info() Info {
+	c.infoMu.Lock()
+	defer c.infoMu.Unlock()
+	return c.info
+}
+
+// Notice sends a NOTICE from the server to the IRC client once it has
+// registered. It may be called from any goroutine, and doesn't wait for the
+// notice to be sent.
+func (c *Client) Notice(message string) {
+	callback := func() error {
+		for _, line := range strings.Split(message, "\n") {
+			if err := replies.NOTICE(c.ilayer, c.ilayer.ServerPrefix(),
+				c.ilayer.ClientPrefix().Name, line); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	go func() {
+		select {
+		case c.callbacks <- callback:
+		case <-c.done:
+		}
+	}()
+}
+
+// QueuedEvents returns the number of Discord events waiting to be written to
+// the IRC client.
+func (c *Client) QueuedEvents() int {
+	return int(atomic.LoadInt32(&c.queued))
+}
+
+// DroppedEvents returns the number of Discord events that were left unwritten
+// when the client shut down.
+func (c *Client) DroppedEvents() int {
+	return int(atomic.LoadInt32(&c.dropped))
+}
+
+// Done returns a channel that is closed once Run has returned.
+func (c *Client) Done() <-chan struct{} {
+	return c.done
+}
+
+// closeLink handles a shutdown request, writing the Discord events already
+// queued and waiting for uploads in progress before sending the ERROR that
+// ends the connection. events is nil before the client has registered.
+func (c *Client) closeLink(req shutdown, events <-chan interface{}) error {
+	timer := time.NewTimer(time.Until(req.deadline))
+	defer timer.Stop()
+
+wait:
+	for c.uploads > 0 || c.QueuedEvents() > 0 {
+		select {
+		case event := <-events:
+			atomic.AddInt32(&c.queued, -1)
+			if err := c.handleDiscordEvent(event); err != nil {
+				return err
+			}
+		case callback := <-c.callbacks:
+			if err := callback(); err != nil {
+				return err
+			}
+		case <-timer.C:
+			if c.uploads > 0 {
+				c.log.Warnf("abandoning %d uploads", c.uploads)
+			}
+			if queued := c.QueuedEvents(); queued > 0 {
+				c.log.Warnf("dropping %d Discord events", queued)
+				atomic.StoreInt32(&c.dropped, int32(queued))
+			}
+			break wait
+		}
+	}
+
+	return replies.ERROR(c.ilayer,
+		fmt.Sprintf("Closing link (%s)", req.reason))
+}
+
 func (c *Client) isGuild() bool {
 	return c.guild.Valid()
 }
@@ -103,6 +278,8 @@ func (c *Client) ircReadLoop(msgs chan<- *irc.Message) {
 }
 
 func (c *Client) Run() error {
+	defer close(c.done)
+
 	msgs := make(chan *irc.Message)
 	go c.ircReadLoop(msgs)
 
@@ -112,13 +289,17 @@ func (c *Client) Run() error {
 			if err := c.ilayer.HandleMessage(msg); err != nil {
 				return err
 			}
+		case req := <-c.shutdown:
+			return c.closeLink(req, nil)
 		case err := <-c.errors:
 			return err
 		}
 	}
 
-	events, cancel := c.session.ChanFor(
-		func(interface{}) bool { return true })
+	events, cancel := c.session.ChanFor(func(interface{}) bool {
+		atomic.AddInt32(&c.queued, 1)
+		return true
+	})
 	defer cancel()
 
 	listCancel := c.session.SubscribeUserList(c.guild,
@@ -134,9 +315,16 @@ func (c *Client) Run() error {
 				return err
 			}
 		case event := <-events:
+			atomic.AddInt32(&c.queued, -1)
 			if err := c.handleDiscordEvent(event); err != nil {
 				return err
 			}
+		case callback := <-c.callbacks:
+			if err := callback(); err != nil {
+				return err
+			}
+		case req := <-c.shutdown:
+			return c.closeLink(req, events)
 		case err := <-c.errors:
 			return err
 		}
@@ -210,11 +398,11 @@ func (c *Client) NetworkName() (string, error) {
 }
 
 func (c *Client) ServerName() (string, error) {
-	return "ircdiscord", nil
+	return c.config.ServerName, nil
 }
 
 func (c *Client) ServerVersion() (string, error) {
-	return "git", nil
+	return c.config.ServerVersion, nil
 }
 
 func (c *Client) ServerCreated() (time.Time, error) {
@@ -232,7 +420,7 @@ func (c *Client) ServerCreated() (time.Time, error) {
 }
 
 func (c *Client) MOTD() ([]string, error) {
-	return []string{}, nil
+	return c.config.MOTD, nil
 }
 
 // This function is called from multiple goroutines.
@@ -0,0 +1,34 @@
+package client
+
+import "github.com/diamondburned/arikawa/discord"
+
+// contentCacheSize is the number of messages whose content is remembered.
+const contentCacheSize = 1000
+
+// contentCache remembers the content of recent messages, so that edits can
+// be told apart from other message updates and compared to what they
+// replaced.
+type contentCache struct {
+	content map[discord.Snowflake]string
+	order   []discord.Snowflake // oldest first
+}
+
+func newContentCache() *contentCache {
+	return &contentCache{content: make(map[discord.Snowflake]string)}
+}
+
+func (c *contentCache) get(id discord.Snowflake) (string, bool) {
+	content, ok := c.content[id]
+	return content, ok
+}
+
+func (c *contentCache) put(id discord.Snowflake, content string) {
+	if _, ok := c.content[id]; !ok {
+		if len(c.order) == contentCacheSize {
+			delete(c.content, c.order[0])
+			c.order = c.order[1:]
+		}
+		c.order = append(c.order, id)
+	}
+	c.content[id] = content
+}
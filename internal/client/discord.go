@@ -3,7 +3,9 @@ package client
 import (
 	"github.com/diamondburned/arikawa/discord"
 	"github.com/diamondburned/arikawa/gateway"
+	"github.com/tadeokondrak/ircdiscord/internal/metrics"
 	"github.com/tadeokondrak/ircdiscord/internal/render"
+	"github.com/tadeokondrak/ircdiscord/internal/session"
 	"gopkg.in/irc.v3"
 )
 
@@ -19,35 +21,66 @@ func (c *Client) discordUserPrefix(u *discord.User) *irc.Prefix {
 	}
 }
 
-func (c *Client) sendDiscordMessage(m *discord.Message, autojoin bool) error {
+// isBlocked returns whether the user has blocked the author of a message.
+func (c *Client) isBlocked(id discord.Snowflake) bool {
 	// TODO: arikawa should store relationships in its state
 	for _, rel := range c.session.Ready.Relationships {
-		if rel.User.ID == m.Author.ID &&
+		if rel.User.ID == id &&
 			rel.Type == discord.BlockedRelationship {
-			return nil
+			return true
 		}
 	}
+	return false
+}
+
+// discordChannelName returns the IRC channel a message belongs in.
+func (c *Client) discordChannelName(m *discord.Message) (string, error) {
+	if c.isGuild() {
+		return c.session.ChannelName(m.GuildID, m.ChannelID)
+	}
 
 	channel, err := c.session.Channel(m.ChannelID)
 	if err != nil {
-		return err
+		return "", err
 	}
 
-	var channelName string
+	recip := channel.DMRecipients[0]
+	channelName, err := c.session.UserName(c.guild, recip.ID)
+	if err != nil {
+		channelName = recip.Username
+	}
+	return channelName, nil
+}
 
-	if c.isGuild() {
-		var err error
-		channelName, err = c.session.ChannelName(m.GuildID, m.ChannelID)
-		if err != nil {
-			return err
-		}
-	} else {
-		recip := channel.DMRecipients[0]
-		var err error
-		channelName, err = c.session.UserName(c.guild, recip.ID)
-		if err != nil {
-			channelName = recip.Username
-		}
+// messageTags returns the tags sent with a message.
+func messageTags(m *discord.Message) irc.Tags {
+	return irc.Tags{"msgid": irc.TagValue(m.ID.String())}
+}
+
+// stickers looks up the stickers sent with m. Messages don't say whether
+// they have any, so only ones with nothing else to show are checked.
+func (c *Client) stickers(m *discord.Message) []session.Sticker {
+	if m.Content != "" || len(m.Embeds) != 0 || len(m.Attachments) != 0 {
+		return nil
+	}
+	stickers, err := c.session.MessageStickers(m.ChannelID, m.ID)
+	if err != nil {
+		c.log.With("error", err).Debugf("failed to get stickers of %v", m.ID)
+		return nil
+	}
+	return stickers
+}
+
+func (c *Client) sendDiscordMessage(m *discord.Message, autojoin bool) error {
+	if c.isBlocked(m.Author.ID) {
+		return nil
+	}
+
+	c.contents.put(m.ID, m.Content)
+
+	channelName, err := c.discordChannelName(m)
+	if err != nil {
+		return err
 	}
 
 	if autojoin && !c.ilayer.InChannel(channelName) {
@@ -58,13 +91,62 @@ func (c *Client) sendDiscordMessage(m *discord.Message, autojoin bool) error {
 		return err
 	}
 
-	message, err := render.Message(c.guild, c.session, m)
+	log := c.log.With("channel", m.ChannelID)
+
+	message, err := render.Message(c.guild, c.session, m, c.stickers(m),
+		c.render)
 	if err != nil {
+		metrics.RenderErrors.Inc()
+		log.With("error", err).Warnf("failed to render message %v", m.ID)
 		return err
 	}
 
+	log.Debugf("relaying message %v", m.ID)
+	metrics.MessagesRelayed.With(metrics.ToIRC).Inc()
+
 	return c.ilayer.Message(channelName, message,
-		c.discordUserPrefix(&m.Author), m.ID.Time())
+		c.discordUserPrefix(&m.Author), m.ID.Time(), messageTags(m))
+}
+
+// sendDiscordEdit shows an edit of a message whose content used to be old,
+// or as a single line with the new content if the old one isn't known.
+func (c *Client) sendDiscordEdit(m *discord.Message, old string,
+	known bool) error {
+	if c.isBlocked(m.Author.ID) {
+		return nil
+	}
+
+	c.contents.put(m.ID, m.Content)
+
+	channelName, err := c.discordChannelName(m)
+	if err != nil {
+		return err
+	}
+
+	if !c.ilayer.InChannel(channelName) {
+		return nil
+	}
+
+	var message string
+	tags := irc.Tags{"+draft/edit": irc.TagValue(m.ID.String())}
+	switch {
+	case !known:
+		message = render.EditedLine(c.guild, c.session, m, c.render)
+	case c.render.Edits == render.TaggedEdits &&
+		c.ilayer.HasCapability("message-tags"):
+		message, err = render.Message(c.guild, c.session, m, c.stickers(m),
+			c.render)
+		if err != nil {
+			metrics.RenderErrors.Inc()
+			return err
+		}
+	default:
+		message = render.Edit(c.guild, c.session, old, m, c.render)
+		tags = irc.Tags{}
+	}
+
+	return c.ilayer.Message(channelName, message,
+		c.discordUserPrefix(&m.Author), m.EditedTimestamp.Time(), tags)
 }
 
 func (c *Client) handleDiscordEvent(e gateway.Event) error {
@@ -101,7 +183,7 @@ func (c *Client) handleDiscordEvent(e gateway.Event) error {
 	case *gateway.MessageCreateEvent:
 		return c.handleDiscordMessage(&e.Message)
 	case *gateway.MessageUpdateEvent:
-		return c.handleDiscordMessage(&e.Message)
+		return c.handleDiscordEdit(&e.Message)
 	case *gateway.MessageDeleteEvent:
 	case *gateway.MessageDeleteBulkEvent:
 	case *gateway.MessageReactionAddEvent:
@@ -124,24 +206,53 @@ func (c *Client) handleDiscordEvent(e gateway.Event) error {
 	return nil
 }
 
-func (c *Client) handleDiscordMessage(m *discord.Message) error {
+// isRelevant returns whether a message belongs to this client's guild, or
+// to a DM when the client is for DMs.
+func (c *Client) isRelevant(m *discord.Message) (bool, error) {
 	if c.isGuild() {
-		if m.GuildID != c.guild {
-			return nil
-		}
-	} else {
-		channel, err := c.session.Channel(m.ChannelID)
-		if err != nil {
-			return err
-		}
-		if channel.Type != discord.DirectMessage {
-			return nil
-		}
+		return m.GuildID == c.guild, nil
+	}
+
+	channel, err := c.session.Channel(m.ChannelID)
+	if err != nil {
+		return false, err
+	}
+	return channel.Type == discord.DirectMessage, nil
+}
+
+func (c *Client) handleDiscordMessage(m *discord.Message) error {
+	if relevant, err := c.isRelevant(m); err != nil || !relevant {
+		return err
 	}
 
 	if m.ID == c.lastMessageID && !c.ilayer.HasCapability("echo-message") {
+		c.contents.put(m.ID, m.Content)
 		return nil
 	}
 
 	return c.sendDiscordMessage(m, !c.isGuild())
 }
+
+// handleDiscordEdit relays edits that change a message's content. Updates
+// also arrive when Discord adds link previews, when messages are pinned and
+// so on, which are ignored.
+func (c *Client) handleDiscordEdit(m *discord.Message) error {
+	if relevant, err := c.isRelevant(m); err != nil || !relevant {
+		return err
+	}
+
+	// partial updates leave out the author and content, and only edits
+	// by the author have an edit time
+	if !m.Author.ID.Valid() || m.Content == "" ||
+		!m.EditedTimestamp.Valid() {
+		return nil
+	}
+
+	// the message may be older than the cache, or than the client
+	old, ok := c.contents.get(m.ID)
+	if ok && old == m.Content {
+		return nil
+	}
+
+	return c.sendDiscordEdit(m, old, ok)
+}
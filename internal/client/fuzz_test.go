@@ -0,0 +1,91 @@
+//go:build go1.18
+// +build go1.18
+
+package client
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/diamondburned/arikawa/discord"
+)
+
+var userMentionRegex = regexp.MustCompile(`<@(\d+)>`)
+
+func FuzzReplaceMentions(f *testing.F) {
+	f.Add("hi @alice")
+	f.Add("@alice, @bob: @carol @ @@alice")
+	f.Add("mail me at x@bob.com or @bob")
+	f.Add("@everyone @here")
+	f.Add("`@alice` @alice ```@bob``` `@carol")
+
+	ids := map[string]discord.Snowflake{"alice": 1, "bob": 22, "Bob": 333}
+	names := make(map[discord.Snowflake]string)
+	for name, id := range ids {
+		names[id] = name
+	}
+
+	lookup := func(name string) (string, bool) {
+		if id, ok := ids[name]; ok {
+			return fmt.Sprintf("<@%d>", id), true
+		}
+		return "", false
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		out := replaceMentions(s, lookup)
+
+		if utf8.ValidString(s) && !utf8.ValidString(out) {
+			t.Errorf("invalid UTF-8 in %q", out)
+		}
+		for _, c := range []string{"\r", "\n", "\x00"} {
+			if strings.Count(out, c) != strings.Count(s, c) {
+				t.Errorf("%q changed the number of %q", out, c)
+			}
+		}
+
+		// turning the mentions back into names gives the original message
+		if strings.Contains(s, "<@") {
+			return
+		}
+		back := userMentionRegex.ReplaceAllStringFunc(out,
+			func(mention string) string {
+				id, err := strconv.ParseUint(mention[2:len(mention)-1], 10, 64)
+				if err != nil {
+					t.Fatalf("invalid mention %q", mention)
+				}
+				return "@" + names[discord.Snowflake(id)]
+			})
+		if back != s {
+			t.Errorf("%q became %q and then %q", s, out, back)
+		}
+	})
+}
+
+func FuzzApplyRegexEdit(f *testing.F) {
+	f.Add("s/foo/bar/", "foo foo")
+	f.Add("s/o/0/g", "foo foo")
+	f.Add(`s/(\w+) (\w+)/$2 $1/`, "hello world")
+	f.Add(`s/a\/b/c/`, "a/b")
+	f.Add("s/[/x/", "[")
+
+	f.Fuzz(func(t *testing.T, command, content string) {
+		result, err := applyRegexEdit(command, content)
+		if err != nil {
+			return
+		}
+
+		if utf8.ValidString(command) && utf8.ValidString(content) &&
+			!utf8.ValidString(result) {
+			t.Errorf("invalid UTF-8 in %q", result)
+		}
+		if strings.ContainsAny(result, "\r\n\x00") &&
+			!strings.ContainsAny(command+content, "\r\n\x00") {
+			t.Errorf("%q added a line break or NUL: %q", command, result)
+		}
+	})
+}
@@ -8,28 +8,198 @@ import (
 
 	"github.com/diamondburned/arikawa/discord"
 	"github.com/diamondburned/arikawa/gateway"
+	"github.com/tadeokondrak/ircdiscord/internal/emoji"
 	"github.com/tadeokondrak/ircdiscord/internal/ilayer"
+	"github.com/tadeokondrak/ircdiscord/internal/metrics"
 	"github.com/tadeokondrak/ircdiscord/internal/render"
 	"github.com/tadeokondrak/ircdiscord/internal/session"
 )
 
 var pingRegex = regexp.MustCompile(`@[^ ]*`)
 
-func (c *Client) replaceIRCMentions(s string) string {
-	return pingRegex.ReplaceAllStringFunc(s, func(match string) string {
-		if match == "@" {
-			return match
+// replaceIRCMentions turns @nick and @role into Discord mentions.
+// @everyone, @here and roles that aren't mentionable are only let through if
+// the user has permission to mention everyone in the channel.
+func (c *Client) replaceIRCMentions(channelID discord.Snowflake,
+	s string) string {
+	canMentionEveryone := c.canMentionEveryone(channelID)
+
+	return replaceMentions(s, func(name string) (string, bool) {
+		if id := c.session.UserFromName(c.guild, name); id.Valid() {
+			return fmt.Sprintf("<@%d>", id), true
 		}
-		id := c.session.UserFromName(c.guild, match[1:])
-		if !id.Valid() {
-			return match
+
+		if name == "everyone" || name == "here" {
+			if canMentionEveryone {
+				return "@" + name, true
+			}
+			// keep Discord from treating it as a mention
+			return "@\u200b" + name, true
 		}
-		return fmt.Sprintf("<@%d>", id)
+
+		if role := c.roleFromName(name); role != nil &&
+			(role.Mentionable || canMentionEveryone) {
+			return fmt.Sprintf("<@&%d>", role.ID), true
+		}
+
+		return "", false
 	})
 }
 
+// replaceMentions replaces each @name in s with what lookup returns for name,
+// leaving it alone if lookup returns false, or if it's inside code.
+func replaceMentions(s string, lookup func(name string) (string, bool)) string {
+	return outsideCode(s, func(s string) string {
+		return pingRegex.ReplaceAllStringFunc(s, func(match string) string {
+			if match == "@" {
+				return match
+			}
+
+			if mention, ok := lookup(match[1:]); ok {
+				return mention
+			}
+
+			return match
+		})
+	})
+}
+
+// outsideCode applies replace to the parts of s that aren't in a code span
+// or code block, which Discord shows as written. A code span starts with a
+// run of backticks and ends with a run of the same length; a run with no
+// match is plain text.
+func outsideCode(s string, replace func(string) string) string {
+	var b strings.Builder
+	text := 0 // start of the text not yet written
+	for i := 0; i < len(s); {
+		if s[i] != '`' {
+			i++
+			continue
+		}
+		n := backticks(s[i:])
+		end := -1
+		for j := i + n; j < len(s); {
+			if s[j] != '`' {
+				j++
+				continue
+			}
+			m := backticks(s[j:])
+			if m == n {
+				end = j + m
+				break
+			}
+			j += m
+		}
+		if end < 0 {
+			i += n
+			continue
+		}
+		b.WriteString(replace(s[text:i]))
+		b.WriteString(s[i:end])
+		text, i = end, end
+	}
+	b.WriteString(replace(s[text:]))
+	return b.String()
+}
+
+// backticks returns the number of backticks s starts with.
+func backticks(s string) int {
+	n := 0
+	for n < len(s) && s[n] == '`' {
+		n++
+	}
+	return n
+}
+
+var emojiRegex = regexp.MustCompile(`:([A-Za-z0-9_+-]+):`)
+
+// replaceIRCEmojis turns :name: outside code into the guild's custom emoji
+// of that name, or the Unicode emoji with that shortcode.
+func (c *Client) replaceIRCEmojis(s string) string {
+	return outsideCode(s, c.replaceEmojis)
+}
+
+func (c *Client) replaceEmojis(s string) string {
+	var b strings.Builder
+	last := 0
+	for _, match := range emojiRegex.FindAllStringSubmatchIndex(s, -1) {
+		start, end := match[0], match[1]
+		name := s[match[2]:match[3]]
+
+		// leave <:name:id> and <a:name:id> alone
+		if strings.HasSuffix(s[:start], "<") ||
+			strings.HasSuffix(s[:start], "<a") {
+			continue
+		}
+
+		replacement, ok := emoji.Lookup(name)
+		if c.isGuild() {
+			if custom, found := c.session.EmojiFromName(
+				c.guild, name); found {
+				replacement, ok = custom.String(), true
+			}
+		}
+		if !ok {
+			continue
+		}
+
+		b.WriteString(s[last:start])
+		b.WriteString(replacement)
+		last = end
+	}
+	b.WriteString(s[last:])
+	return b.String()
+}
+
+// canMentionEveryone returns whether the user may mention @everyone, @here
+// and unmentionable roles in the given channel.
+func (c *Client) canMentionEveryone(channelID discord.Snowflake) bool {
+	if !c.isGuild() {
+		return false
+	}
+
+	me, err := c.session.Me()
+	if err != nil {
+		return false
+	}
+
+	perms, err := c.session.Permissions(channelID, me.ID)
+	if err != nil {
+		return false
+	}
+
+	return perms.Has(discord.PermissionMentionEveryone)
+}
+
+// roleFromName returns the role in the current guild called name, ignoring
+// case and spaces since IRC mentions can't contain them.
+func (c *Client) roleFromName(name string) *discord.Role {
+	if !c.isGuild() {
+		return nil
+	}
+
+	roles, err := c.session.Roles(c.guild)
+	if err != nil {
+		return nil
+	}
+
+	for _, role := range roles {
+		if role.Name == "@everyone" {
+			continue
+		}
+		if strings.EqualFold(strings.ReplaceAll(role.Name, " ", ""), name) {
+			return &role
+		}
+	}
+
+	return nil
+}
+
 func (c *Client) HandleRegister() error {
 	if c.session == nil {
+		if !c.config.PassLogin {
+			return fmt.Errorf("not logged in, log in with SASL")
+		}
 		return fmt.Errorf("no session provided")
 	}
 
@@ -38,8 +208,18 @@ func (c *Client) HandleRegister() error {
 		return err
 	}
 
+	c.log = c.logger.With("user", me.ID, "guild", c.guild).
+		Subsystem("client")
+	c.log.Infof("logged in as %s", me.Username)
+
 	c.ilayer.SetClientPrefix(c.discordUserPrefix(me))
 
+	if user, ok := c.config.Users[me.ID]; ok {
+		opts := *user.Render
+		c.render = &opts
+		c.backlog = user.Backlog
+	}
+
 	if err := c.seedState(); err != nil {
 		return err
 	}
@@ -72,28 +252,48 @@ func (c *Client) HandleRealname(realname string) (string, error) {
 }
 
 func (c *Client) HandlePassword(password string) (string, error) {
-	if c.session != nil {
-		c.session.Unref()
-		c.session = nil
+	if c.ilayer.Account() != "" || !c.config.PassLogin {
+		// logged in with SASL, or tokens aren't accepted here
+		return password, nil
 	}
 
 	args := strings.SplitN(password, ":", 2)
-	session, err := c.sessionFunc(args[0], c.discordDebug)
-	if err != nil {
-		return "", err
-	}
-
-	c.session = session
 
+	var guild discord.Snowflake
 	if len(args) > 1 {
 		snowflake, err := discord.ParseSnowflake(args[1])
 		if err != nil {
 			return "", err
 		}
+		guild = snowflake
+	}
+
+	if err := c.login(args[0], guild); err != nil {
+		return "", err
+	}
+
+	return password, nil
+}
+
+// login replaces the client's session with the one for token, on guild if
+// it is valid or in DMs otherwise.
+func (c *Client) login(token string, guild discord.Snowflake) error {
+	if c.session != nil {
+		c.session.Unref()
+		c.session = nil
+	}
+
+	session, err := c.sessionFunc(token)
+	if err != nil {
+		return err
+	}
+
+	c.session = session
 
-		guild, err := c.session.Guild(snowflake)
+	if guild.Valid() {
+		guild, err := c.session.Guild(guild)
 		if err != nil {
-			return "", err
+			return err
 		}
 
 		c.session.Gateway.GuildSubscribe(gateway.GuildSubscribeData{
@@ -103,7 +303,12 @@ func (c *Client) HandlePassword(password string) (string, error) {
 		c.guild = guild.ID
 	}
 
-	return password, nil
+	c.infoMu.Lock()
+	c.info.User = session.ID()
+	c.info.Guild = c.guild
+	c.infoMu.Unlock()
+
+	return nil
 }
 
 func (c *Client) HandlePing(nonce string) (string, error) {
@@ -168,7 +373,8 @@ func (c *Client) HandleJoin(name string) error {
 		return err
 	}
 
-	backlog, err := c.session.Messages(channel.ID)
+	// the backlog is ordered newest first
+	backlog, err := c.session.LatestMessages(channel.ID, c.backlog)
 	if err != nil {
 		return err
 	}
@@ -184,22 +390,44 @@ func (c *Client) HandleJoin(name string) error {
 
 var actionRegex = regexp.MustCompile(`^\x01ACTION (.*)\x01$`)
 
-func (c *Client) HandleMessage(channel, content string) error {
-	var channelID discord.Snowflake
+// channelID returns the Discord channel for an IRC channel name, or the DM
+// channel for a nickname outside of a guild.
+func (c *Client) channelID(channel string) (discord.Snowflake, error) {
 	if c.isGuild() {
-		channelID = c.session.ChannelFromName(c.guild, channel)
-	} else {
-		user := c.session.UserFromName(c.guild, channel)
-		if !user.Valid() {
-			return fmt.Errorf("no user named %s", channel)
+		channelID := c.session.ChannelFromName(c.guild, channel)
+		if !channelID.Valid() {
+			return 0, fmt.Errorf("no channel named %s", channel)
 		}
+		return channelID, nil
+	}
 
-		channel, err := c.session.CreatePrivateChannel(user)
-		if err != nil {
-			return err
-		}
+	user := c.session.UserFromName(c.guild, channel)
+	if !user.Valid() {
+		return 0, fmt.Errorf("no user named %s", channel)
+	}
+
+	dm, err := c.session.CreatePrivateChannel(user)
+	if err != nil {
+		return 0, err
+	}
+
+	return dm.ID, nil
+}
+
+func (c *Client) HandleMessage(channel, content string) error {
+	if offer, err := parseDCCSend(content); err != nil {
+		return c.serviceReply("DCC SEND: %v", err)
+	} else if offer != nil {
+		return c.handleDCCSend(channel, offer)
+	}
+
+	if channel == serviceName {
+		return c.handleServiceMessage(content)
+	}
 
-		channelID = channel.ID
+	channelID, err := c.channelID(channel)
+	if err != nil {
+		return err
 	}
 
 	if strings.HasPrefix(content, "s/") {
@@ -207,29 +435,63 @@ func (c *Client) HandleMessage(channel, content string) error {
 	}
 
 	content = actionRegex.ReplaceAllString(content, "*$1*")
-	content = c.replaceIRCMentions(content)
+	content = c.replaceIRCMentions(channelID, content)
+	content = c.replaceIRCEmojis(content)
 
 	msg, err := c.session.SendMessage(channelID, content, nil)
 	if err != nil {
 		return err
 	}
 	c.lastMessageID = msg.ID
+	c.lastChannel = channel
+	metrics.MessagesRelayed.With(metrics.ToDiscord).Inc()
+
+	c.log.With("channel", channelID).Debugf("sent message %v", msg.ID)
 
 	return nil
 }
 
 var editRegex = regexp.MustCompile(`^s/((?:\\/|[^/])*)/((?:\\/|[^/])*)(?:/(g?))?$`)
 
-func (c *Client) handleRegexEdit(channelName string,
-	channelID discord.Snowflake, content string) error {
-	matches := editRegex.FindStringSubmatch(content)
+// applyRegexEdit applies an edit command of the form s/regex/replacement/
+// to content. A trailing g replaces every match instead of the first. A / in
+// the regex or replacement is escaped as \/.
+func applyRegexEdit(command, content string) (string, error) {
+	matches := editRegex.FindStringSubmatch(command)
 	if matches == nil {
-		return fmt.Errorf("invalid replacement")
+		return "", fmt.Errorf("invalid replacement")
 	}
 
-	regex, err := regexp.Compile(content)
+	pattern := strings.ReplaceAll(matches[1], `\/`, "/")
+	replacement := strings.ReplaceAll(matches[2], `\/`, "/")
+
+	regex, err := regexp.Compile(pattern)
 	if err != nil {
-		return fmt.Errorf("failed to compile regex: %v", err)
+		return "", fmt.Errorf("failed to compile regex: %v", err)
+	}
+
+	if matches[3] == "g" {
+		result := regex.ReplaceAllString(content, replacement)
+		if result == content {
+			return "", fmt.Errorf("no matches")
+		}
+		return result, nil
+	}
+
+	match := regex.FindStringSubmatchIndex(content)
+	if match == nil {
+		return "", fmt.Errorf("no matches")
+	}
+
+	replaced := regex.ExpandString(nil, replacement, content, match)
+
+	return content[:match[0]] + string(replaced) + content[match[1]:], nil
+}
+
+func (c *Client) handleRegexEdit(channelName string,
+	channelID discord.Snowflake, content string) error {
+	if !editRegex.MatchString(content) {
+		return fmt.Errorf("invalid replacement")
 	}
 
 	channel := c.session.ChannelFromName(c.guild,
@@ -265,31 +527,12 @@ func (c *Client) handleRegexEdit(channelName string,
 		return err
 	}
 
-	beforeEdit := message.Content
-
-	var result string
-
-	if matches[3] == "g" {
-		result = regex.ReplaceAllString(beforeEdit, matches[2])
-		if result == beforeEdit {
-			return fmt.Errorf("no matches")
-		}
-
-	} else {
-		match := regex.FindStringSubmatchIndex(beforeEdit)
-		if match == nil {
-			return fmt.Errorf("no matches")
-		}
-
-		dst := []byte{}
-		replaced := regex.ExpandString(
-			dst, matches[2], beforeEdit, match)
-
-		result = beforeEdit[:match[0]] +
-			string(replaced) + beforeEdit[match[1]:]
+	result, err := applyRegexEdit(content, message.Content)
+	if err != nil {
+		return err
 	}
 
-	_, err = c.session.EditMessage(message.ChannelID, message.ID, string(result), nil, false)
+	_, err = c.session.EditMessage(message.ChannelID, message.ID, result, nil, false)
 
 	return err
 }
@@ -318,7 +561,7 @@ func (c *Client) HandleList() ([]ilayer.ListEntry, error) {
 			}
 
 			topic := render.Content(c.guild, c.session,
-				[]byte(channel.Topic), nil)
+				[]byte(channel.Topic), nil, c.render)
 			entry.Topic = strings.ReplaceAll(topic, "\n", " ")
 
 			entries = append(entries, entry)
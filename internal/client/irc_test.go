@@ -0,0 +1,59 @@
+package client
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyRegexEdit(t *testing.T) {
+	for _, test := range []struct {
+		command, content, result string
+	}{
+		{"s/foo/bar/", "foo foo", "bar foo"},
+		{"s/foo/bar", "foo foo", "bar foo"},
+		{"s/o/0/g", "foo foo", "f00 f00"},
+		{`s/(\w+) (\w+)/$2 $1/`, "hello world", "world hello"},
+		{`s/a\/b/c\/d/`, "a/b", "c/d"},
+		// the regex is the part between the slashes, not the whole command
+		{"s/a.c/x/", "abc s/a.c/x/", "x s/a.c/x/"},
+		{"s/^foo$/bar/", "foo", "bar"},
+	} {
+		result, err := applyRegexEdit(test.command, test.content)
+		assert.NoError(t, err, test.command)
+		assert.Equal(t, test.result, result, test.command)
+	}
+
+	for _, command := range []string{"s/x/y/", "s/[/x/", "s/a/b/c/"} {
+		_, err := applyRegexEdit(command, "abc")
+		assert.Error(t, err, command)
+	}
+}
+
+func TestDebugLine(t *testing.T) {
+	for line, want := range map[string]string{
+		"PASS secret:1234":                           "PASS [redacted]",
+		"PRIVMSG ircdiscord :register alice hunter2": "PRIVMSG ircdiscord :register alice [redacted]",
+		"privmsg IRCDISCORD register alice hunter2":  "privmsg IRCDISCORD register alice [redacted]",
+		"PRIVMSG ircdiscord :help":                   "PRIVMSG ircdiscord :help",
+		"PRIVMSG #general :register alice hunter2":   "PRIVMSG #general :register alice hunter2",
+	} {
+		assert.Equal(t, want, debugLine(line), line)
+	}
+}
+
+func TestOutsideCode(t *testing.T) {
+	upper := strings.ToUpper
+	for in, want := range map[string]string{
+		"hi":                     "HI",
+		"a `b` c":                "A `b` C",
+		"a ``b ` c`` d":          "A ``b ` c`` D",
+		"```\nfoo @bar\n``` baz": "```\nfoo @bar\n``` BAZ",
+		"a `b":                   "A `B",
+		"a ``b` c":               "A ``B` C",
+		"`a` b `c`":              "`a` B `c`",
+	} {
+		assert.Equal(t, want, outsideCode(in, upper), in)
+	}
+}
@@ -0,0 +1,113 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"strings"
+
+	"github.com/diamondburned/arikawa/discord"
+	"github.com/tadeokondrak/ircdiscord/internal/accounts"
+)
+
+// peerCertificate returns the certificate the IRC client presented, or nil.
+func (c *Client) peerCertificate() *x509.Certificate {
+	conn, ok := c.netconn.(*tls.Conn)
+	if !ok {
+		return nil
+	}
+	certs := conn.ConnectionState().PeerCertificates
+	if len(certs) == 0 {
+		return nil
+	}
+	return certs[0]
+}
+
+// SASLMechanisms offers PLAIN only where the password can't be read off the
+// network, and EXTERNAL only with TLS.
+func (c *Client) SASLMechanisms() []string {
+	if c.config.Accounts == nil {
+		return nil
+	}
+	if _, ok := c.netconn.(*tls.Conn); ok {
+		return []string{"PLAIN", "EXTERNAL"}
+	}
+	if c.netconn.LocalAddr().Network() == "unix" {
+		return []string{"PLAIN"}
+	}
+	return nil
+}
+
+// splitIdentity splits a SASL identity of the form account or
+// account/guild.
+func splitIdentity(identity string) (string, discord.Snowflake, error) {
+	args := strings.SplitN(identity, "/", 2)
+	if len(args) == 1 {
+		return args[0], 0, nil
+	}
+	guild, err := discord.ParseSnowflake(args[1])
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid guild ID %s", args[1])
+	}
+	return args[0], guild, nil
+}
+
+func (c *Client) HandleAuthenticate(mechanism string,
+	response []byte) (string, error) {
+	account, guild, err := c.authenticate(mechanism, response)
+	if err != nil {
+		c.log.With("mechanism", mechanism, "error", err).
+			Warnf("failed to log in")
+		return "", err
+	}
+
+	if !guild.Valid() {
+		guild = account.Guild
+	}
+
+	if err := c.login(account.Token, guild); err != nil {
+		c.log.With("account", account.Name, "error", err).
+			Warnf("failed to log in to Discord")
+		return "", err
+	}
+
+	return account.Name, nil
+}
+
+// authenticate returns the account logged in to, and the guild asked for if
+// any.
+func (c *Client) authenticate(mechanism string,
+	response []byte) (*accounts.Account, discord.Snowflake, error) {
+	switch mechanism {
+	case "PLAIN":
+		// authorization identity, authentication identity, password
+		parts := strings.Split(string(response), "\x00")
+		if len(parts) != 3 {
+			return nil, 0, fmt.Errorf("malformed response")
+		}
+		if parts[0] != "" && parts[0] != parts[1] {
+			return nil, 0, fmt.Errorf("can't log in as another account")
+		}
+		name, guild, err := splitIdentity(parts[1])
+		if err != nil {
+			return nil, 0, err
+		}
+		account, err := c.config.Accounts.Authenticate(name, parts[2])
+		return account, guild, err
+	case "EXTERNAL":
+		cert := c.peerCertificate()
+		if cert == nil {
+			return nil, 0, fmt.Errorf("no client certificate")
+		}
+		// the authorization identity is optional
+		name, guild, err := splitIdentity(string(response))
+		if err != nil {
+			return nil, 0, err
+		}
+		account, err := c.config.Accounts.AuthenticateCertificate(cert,
+			name)
+		return account, guild, err
+	default:
+		return nil, 0, fmt.Errorf("unsupported mechanism")
+	}
+}
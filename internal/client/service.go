@@ -0,0 +1,254 @@
+package client
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/diamondburned/arikawa/discord"
+	"github.com/tadeokondrak/ircdiscord/internal/accounts"
+	"github.com/tadeokondrak/ircdiscord/internal/render"
+	"github.com/tadeokondrak/ircdiscord/internal/replies"
+	"gopkg.in/irc.v3"
+)
+
+// serviceName is the nickname of the pseudo-user that takes commands for
+// ircdiscord itself, rather than messages for Discord.
+const serviceName = "ircdiscord"
+
+var servicePrefix = &irc.Prefix{
+	Name: serviceName,
+	User: serviceName,
+	Host: serviceName,
+}
+
+type serviceCommand struct {
+	usage string
+	help  string
+	run   func(c *Client, args []string) error
+}
+
+var serviceCommands map[string]*serviceCommand
+
+func init() {
+	serviceCommands = map[string]*serviceCommand{
+		"help": {
+			usage: "help",
+			help:  "list the available commands",
+			run:   (*Client).serviceHelp,
+		},
+		"preview": {
+			usage: "preview <channel>",
+			help:  "show the link previews of the latest message with any",
+			run:   (*Client).servicePreview,
+		},
+		"reveal": {
+			usage: "reveal <channel> <message id>",
+			help:  "show a message with its spoilers",
+			run:   (*Client).serviceReveal,
+		},
+		"upload": {
+			usage: "upload <channel> <url or path> [caption]",
+			help: "upload a file from an http(s) or file URL, " +
+				"or a path in the upload directory",
+			run: (*Client).serviceUpload,
+		},
+		"register": {
+			usage: "register <account> <password>",
+			help: "create an account to log in to with SASL from now on, " +
+				"for this Discord user and server, and the client " +
+				"certificate if any",
+			run: (*Client).serviceRegister,
+		},
+		"sticker": {
+			usage: "sticker <channel> <name>",
+			help:  "send one of the server's stickers",
+			run:   (*Client).serviceSticker,
+		},
+	}
+}
+
+// handleServiceMessage runs a command sent to the service.
+// Errors are reported to the user instead of closing the connection.
+func (c *Client) handleServiceMessage(content string) error {
+	args := strings.Fields(content)
+	if len(args) == 0 {
+		args = []string{"help"}
+	}
+
+	cmd, ok := serviceCommands[strings.ToLower(args[0])]
+	if !ok {
+		return c.serviceReply("unknown command %s, try help", args[0])
+	}
+
+	if err := cmd.run(c, args[1:]); err != nil {
+		return c.serviceReply("%s: %v", args[0], err)
+	}
+
+	return nil
+}
+
+// serviceReply sends a notice from the service to the user.
+func (c *Client) serviceReply(format string, args ...interface{}) error {
+	for _, line := range strings.Split(fmt.Sprintf(format, args...), "\n") {
+		if err := replies.NOTICE(c.ilayer, servicePrefix,
+			c.ilayer.ClientPrefix().Name, line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Client) serviceHelp(args []string) error {
+	names := []string{}
+	for name := range serviceCommands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		cmd := serviceCommands[name]
+		if err := c.serviceReply("%s: %s", cmd.usage, cmd.help); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *Client) servicePreview(args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("usage: %s", serviceCommands["preview"].usage)
+	}
+
+	channelID, err := c.channelID(args[0])
+	if err != nil {
+		return err
+	}
+
+	backlog, err := c.session.Messages(channelID)
+	if err != nil {
+		return err
+	}
+
+	// the backlog is ordered newest first
+	for _, m := range backlog {
+		for _, e := range m.Embeds {
+			if render.IsLinkPreview(&m, &e) {
+				return c.sendPreviews(&m)
+			}
+		}
+	}
+
+	return fmt.Errorf("no recent message in %s has link previews", args[0])
+}
+
+func (c *Client) sendPreviews(m *discord.Message) error {
+	return c.serviceReply("%s", render.Previews(c.guild, c.session, m, c.render))
+}
+
+func (c *Client) serviceReveal(args []string) error {
+	if len(args) != 2 {
+		return fmt.Errorf("usage: %s", serviceCommands["reveal"].usage)
+	}
+
+	channelID, err := c.channelID(args[0])
+	if err != nil {
+		return err
+	}
+
+	messageID, err := discord.ParseSnowflake(args[1])
+	if err != nil {
+		return fmt.Errorf("invalid message id %s", args[1])
+	}
+
+	m, err := c.session.Message(channelID, messageID)
+	if err != nil {
+		return err
+	}
+
+	opts := *c.render
+	opts.Spoilers = render.ShowSpoilers
+	message, err := render.Message(c.guild, c.session, m, c.stickers(m), &opts)
+	if err != nil {
+		return err
+	}
+
+	return c.serviceReply("%s", message)
+}
+
+func (c *Client) serviceSticker(args []string) error {
+	if len(args) < 2 {
+		return fmt.Errorf("usage: %s", serviceCommands["sticker"].usage)
+	}
+
+	if !c.isGuild() {
+		return fmt.Errorf("stickers can only be sent from a server")
+	}
+
+	channelID, err := c.channelID(args[0])
+	if err != nil {
+		return err
+	}
+
+	sticker, err := c.session.StickerFromName(c.guild,
+		strings.Join(args[1:], " "))
+	if err != nil {
+		return err
+	}
+
+	msg, err := c.session.SendSticker(channelID, sticker.ID)
+	if err != nil {
+		return err
+	}
+	c.lastMessageID = msg.ID
+
+	return nil
+}
+
+func (c *Client) serviceUpload(args []string) error {
+	if len(args) < 2 {
+		return fmt.Errorf("usage: %s", serviceCommands["upload"].usage)
+	}
+
+	channelID, err := c.channelID(args[0])
+	if err != nil {
+		return err
+	}
+
+	source := args[1]
+	caption := strings.Join(args[2:], " ")
+	c.uploadInBackground("upload", args[0], channelID, caption,
+		func() (*upload, error) { return c.fetchUpload(source) })
+
+	return nil
+}
+
+func (c *Client) serviceRegister(args []string) error {
+	if len(args) != 2 {
+		return fmt.Errorf("usage: %s", serviceCommands["register"].usage)
+	}
+
+	if c.config.Accounts == nil {
+		return fmt.Errorf("accounts are disabled on this server")
+	}
+
+	account := &accounts.Account{
+		Name:  args[0],
+		Token: c.session.Token,
+		Guild: c.guild,
+	}
+	if err := account.SetPassword(args[1]); err != nil {
+		return err
+	}
+	if cert := c.peerCertificate(); cert != nil {
+		account.Fingerprints = []string{accounts.Fingerprint(cert)}
+	}
+
+	if err := c.config.Accounts.Add(account); err != nil {
+		return err
+	}
+
+	return c.serviceReply("registered %s, log in with SASL from now on "+
+		"instead of giving your token", account.Name)
+}
@@ -0,0 +1,430 @@
+package client
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/diamondburned/arikawa/api"
+	"github.com/diamondburned/arikawa/discord"
+	"github.com/tadeokondrak/ircdiscord/internal/metrics"
+)
+
+// UploadOptions limits what can be uploaded to Discord from IRC.
+type UploadOptions struct {
+	// Dir is the directory local files may be uploaded from. Local uploads
+	// are disabled if it is empty.
+	Dir string
+	// MaxSize is the size of the largest file that may be uploaded, in
+	// bytes.
+	MaxSize int64
+	// HTTP is whether files may be fetched from http(s) URLs. Only public
+	// addresses are fetched from, so the bridge can't be used to reach
+	// services on its own network.
+	HTTP bool
+}
+
+// uploadTimeout bounds how long fetching a file may take.
+const uploadTimeout = 2 * time.Minute
+
+var httpClient = &http.Client{
+	Timeout: uploadTimeout,
+	Transport: &http.Transport{
+		// no proxy, which would be dialed instead of the destination
+		DialContext: (&net.Dialer{
+			Timeout: uploadTimeout,
+			Control: dialControl,
+		}).DialContext,
+		TLSHandshakeTimeout: 10 * time.Second,
+	},
+}
+
+// nonPublicNets are the ranges of addresses that aren't on the internet, or
+// can lead back to ones that aren't.
+var nonPublicNets = []*net.IPNet{
+	mustParseCIDR("0.0.0.0/8"), // this network
+	mustParseCIDR("10.0.0.0/8"),
+	mustParseCIDR("100.64.0.0/10"), // carrier-grade NAT
+	mustParseCIDR("127.0.0.0/8"),
+	mustParseCIDR("169.254.0.0/16"),
+	mustParseCIDR("172.16.0.0/12"),
+	mustParseCIDR("192.0.0.0/24"), // protocol assignments
+	mustParseCIDR("192.0.2.0/24"), // documentation
+	mustParseCIDR("192.168.0.0/16"),
+	mustParseCIDR("198.18.0.0/15"), // benchmarking
+	mustParseCIDR("198.51.100.0/24"),
+	mustParseCIDR("203.0.113.0/24"),
+	mustParseCIDR("224.0.0.0/4"),    // multicast
+	mustParseCIDR("240.0.0.0/4"),    // reserved, and broadcast
+	mustParseCIDR("::/96"),          // unspecified, loopback, IPv4-compatible
+	mustParseCIDR("64:ff9b::/96"),   // NAT64, to any IPv4 address
+	mustParseCIDR("64:ff9b:1::/48"), // local NAT64
+	mustParseCIDR("100::/64"),       // discard
+	mustParseCIDR("2001:db8::/32"),  // documentation
+	mustParseCIDR("2002::/16"),      // 6to4, to any IPv4 address
+	mustParseCIDR("fc00::/7"),       // unique local
+	mustParseCIDR("fe80::/10"),      // link-local
+	mustParseCIDR("fec0::/10"),      // site-local
+	mustParseCIDR("ff00::/8"),       // multicast
+}
+
+func mustParseCIDR(s string) *net.IPNet {
+	_, n, err := net.ParseCIDR(s)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+// isPublicIP returns whether ip is an address on the internet, rather than
+// a loopback, private, link-local, multicast, reserved or unspecified one,
+// including IPv4 ones written as IPv6.
+func isPublicIP(ip net.IP) bool {
+	if ip4 := ip.To4(); ip4 != nil {
+		ip = ip4
+	}
+	if ip == nil || ip.IsLoopback() || ip.IsUnspecified() ||
+		ip.IsMulticast() || ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() {
+		return false
+	}
+	for _, n := range nonPublicNets {
+		if n.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// dialControl refuses connections to addresses that aren't public. It is
+// called with the resolved address, so it covers redirects and host names
+// resolving to private addresses too.
+func dialControl(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if ip := net.ParseIP(host); ip == nil || !isPublicIP(ip) {
+		return fmt.Errorf("%s is not a public address", host)
+	}
+	return nil
+}
+
+// upload is a file ready to be sent to Discord.
+type upload struct {
+	name string
+	data []byte
+}
+
+func (c *Client) errTooLarge(name string, size int64) error {
+	return fmt.Errorf("%s is %d bytes, larger than the limit of %d bytes",
+		name, size, c.config.Uploads.MaxSize)
+}
+
+// readUpload reads at most the size limit from r.
+func (c *Client) readUpload(name string, r io.Reader) (*upload, error) {
+	data, err := ioutil.ReadAll(io.LimitReader(r, c.config.Uploads.MaxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > c.config.Uploads.MaxSize {
+		return nil, fmt.Errorf("%s is larger than the limit of %d bytes",
+			name, c.config.Uploads.MaxSize)
+	}
+	return &upload{name: name, data: data}, nil
+}
+
+// fetchUpload reads a file from an http(s) or file URL, or a path in the
+// upload directory.
+func (c *Client) fetchUpload(source string) (*upload, error) {
+	u, err := url.Parse(source)
+	if err != nil {
+		return nil, err
+	}
+
+	switch u.Scheme {
+	case "http", "https":
+		if !c.config.Uploads.HTTP {
+			return nil, fmt.Errorf("uploading from URLs is disabled")
+		}
+		return c.fetchHTTPUpload(u)
+	case "file":
+		return c.fetchLocalUpload(u.Path)
+	case "":
+		return c.fetchLocalUpload(source)
+	default:
+		return nil, fmt.Errorf("unsupported URL scheme %s", u.Scheme)
+	}
+}
+
+func (c *Client) fetchHTTPUpload(u *url.URL) (*upload, error) {
+	resp, err := httpClient.Get(u.String())
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %s", u, resp.Status)
+	}
+
+	name := path.Base(u.Path)
+	if name == "/" || name == "." {
+		name = "file"
+	}
+
+	if resp.ContentLength > c.config.Uploads.MaxSize {
+		return nil, c.errTooLarge(name, resp.ContentLength)
+	}
+
+	return c.readUpload(name, resp.Body)
+}
+
+func (c *Client) fetchLocalUpload(name string) (*upload, error) {
+	if c.config.Uploads.Dir == "" {
+		return nil, fmt.Errorf("uploading local files is disabled")
+	}
+
+	dir, err := filepath.EvalSymlinks(c.config.Uploads.Dir)
+	if err != nil {
+		return nil, err
+	}
+
+	if !filepath.IsAbs(name) {
+		name = filepath.Join(dir, name)
+	}
+	name, err = filepath.EvalSymlinks(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if rel, err := filepath.Rel(dir, name); err != nil ||
+		rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return nil, fmt.Errorf("%s is outside of the upload directory", name)
+	}
+
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if !info.Mode().IsRegular() {
+		return nil, fmt.Errorf("%s is not a regular file", name)
+	}
+	if info.Size() > c.config.Uploads.MaxSize {
+		return nil, c.errTooLarge(filepath.Base(name), info.Size())
+	}
+
+	return c.readUpload(filepath.Base(name), f)
+}
+
+// sendUpload posts a file to a channel, with caption as the message.
+func (c *Client) sendUpload(channelID discord.Snowflake, u *upload,
+	caption string) error {
+	if caption != "" {
+		caption = c.replaceIRCMentions(channelID, caption)
+		caption = c.replaceIRCEmojis(caption)
+	}
+
+	msg, err := c.session.SendMessageComplex(channelID, api.SendMessageData{
+		Content: caption,
+		Files: []api.SendMessageFile{{
+			Name:   u.name,
+			Reader: bytes.NewReader(u.data),
+		}},
+	})
+	if err != nil {
+		return err
+	}
+	c.lastMessageID = msg.ID
+	metrics.MessagesRelayed.With(metrics.ToDiscord).Inc()
+
+	return nil
+}
+
+var dccSendRegex = regexp.MustCompile(
+	`^\x01DCC SEND ("[^"]+"|\S+) (\S+) (\d+) (\d+)(?: \S+)?\x01$`)
+
+// dccSend is an offer to send a file over DCC.
+type dccSend struct {
+	name string
+	addr string
+	size int64
+}
+
+// parseDCCSend parses a DCC SEND request, returning nil if content is not
+// one.
+func parseDCCSend(content string) (*dccSend, error) {
+	matches := dccSendRegex.FindStringSubmatch(content)
+	if matches == nil {
+		return nil, nil
+	}
+
+	name := filepath.Base(strings.Trim(matches[1], `"`))
+
+	// the address is either an IPv4 address as a decimal integer, or an
+	// IPv6 address
+	host := matches[2]
+	if n, err := strconv.ParseUint(host, 10, 32); err == nil {
+		ip := make(net.IP, net.IPv4len)
+		binary.BigEndian.PutUint32(ip, uint32(n))
+		host = ip.String()
+	} else if net.ParseIP(host) == nil {
+		return nil, fmt.Errorf("invalid DCC address %s", host)
+	}
+
+	if matches[3] == "0" {
+		return nil, fmt.Errorf("passive DCC is not supported")
+	}
+
+	size, err := strconv.ParseInt(matches[4], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dccSend{
+		name: name,
+		addr: net.JoinHostPort(host, matches[3]),
+		size: size,
+	}, nil
+}
+
+// checkDCCAddr returns an error unless a file may be received from addr,
+// which must be the IRC client's own address, or a public one for clients
+// on a Unix socket. Otherwise the bridge could be made to read from any
+// service it can reach.
+func (c *Client) checkDCCAddr(addr string) error {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+
+	if tcpAddr, ok := c.netconn.RemoteAddr().(*net.TCPAddr); ok {
+		if !ip.Equal(tcpAddr.IP) {
+			return fmt.Errorf("files can only be sent from %v, "+
+				"the address you are connected from", tcpAddr.IP)
+		}
+		return nil
+	}
+
+	if !isPublicIP(ip) {
+		return fmt.Errorf("%s is not a public address", host)
+	}
+	return nil
+}
+
+// receiveDCC connects to the sender and reads the file.
+func (c *Client) receiveDCC(offer *dccSend) (*upload, error) {
+	conn, err := net.DialTimeout("tcp", offer.addr, uploadTimeout)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(uploadTimeout))
+
+	data := make([]byte, 0, offer.size)
+	buf := make([]byte, 32*1024)
+	ack := make([]byte, 4)
+	for int64(len(data)) < offer.size {
+		n, err := conn.Read(buf)
+		data = append(data, buf[:n]...)
+		if n > 0 {
+			// senders wait for the number of bytes received so far
+			binary.BigEndian.PutUint32(ack, uint32(len(data)))
+			if _, err := conn.Write(ack); err != nil {
+				return nil, err
+			}
+		}
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+	}
+
+	if int64(len(data)) != offer.size {
+		return nil, fmt.Errorf("%s: received %d of %d bytes",
+			offer.name, len(data), offer.size)
+	}
+
+	return &upload{name: offer.name, data: data}, nil
+}
+
+// handleDCCSend accepts a file sent over DCC to target and uploads it to the
+// matching Discord channel, or the channel last sent to if the target is the
+// service. The transfer happens in the background, and its result is
+// reported by the service.
+func (c *Client) handleDCCSend(target string, offer *dccSend) error {
+	channel := target
+	if target == serviceName {
+		if c.lastChannel == "" {
+			return c.serviceReply("DCC SEND: send a message to a " +
+				"channel first, files sent to me go to the last one")
+		}
+		channel = c.lastChannel
+	}
+
+	channelID, err := c.channelID(channel)
+	if err != nil {
+		return c.serviceReply("DCC SEND: %v", err)
+	}
+
+	if err := c.checkDCCAddr(offer.addr); err != nil {
+		return c.serviceReply("DCC SEND: %v", err)
+	}
+
+	if offer.size > c.config.Uploads.MaxSize {
+		return c.serviceReply("DCC SEND: %v",
+			c.errTooLarge(offer.name, offer.size))
+	}
+
+	c.uploadInBackground("DCC SEND", channel, channelID, "",
+		func() (*upload, error) { return c.receiveDCC(offer) })
+
+	return nil
+}
+
+// uploadInBackground fetches a file without blocking the client, then
+// uploads it and reports the result through the service.
+func (c *Client) uploadInBackground(what, channel string,
+	channelID discord.Snowflake, caption string,
+	fetch func() (*upload, error)) {
+	c.uploads++
+	go func() {
+		u, err := fetch()
+		callback := func() error {
+			c.uploads--
+			if err == nil {
+				err = c.sendUpload(channelID, u, caption)
+			}
+			if err != nil {
+				return c.serviceReply("%s: %v", what, err)
+			}
+			return c.serviceReply("uploaded %s to %s", u.name, channel)
+		}
+		select {
+		case c.callbacks <- callback:
+		case <-c.done:
+			// the client is gone
+		}
+	}()
+}
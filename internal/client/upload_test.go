@@ -0,0 +1,60 @@
+package client
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsPublicIP(t *testing.T) {
+	for addr, public := range map[string]bool{
+		"1.1.1.1":                true,
+		"2606:4700::1111":        true,
+		"127.0.0.1":              false,
+		"::1":                    false,
+		"10.1.2.3":               false,
+		"172.20.0.1":             false,
+		"192.168.1.1":            false,
+		"100.64.0.1":             false,
+		"169.254.169.254":        false,
+		"fe80::1":                false,
+		"fd00::1":                false,
+		"0.0.0.0":                false,
+		"::":                     false,
+		"::ffff:10.0.0.1":        false,
+		"0.1.2.3":                false,
+		"100.127.255.254":        false,
+		"192.0.0.8":              false,
+		"198.18.0.1":             false,
+		"240.0.0.1":              false,
+		"255.255.255.255":        false,
+		"224.0.0.1":              false,
+		"::ffff:127.0.0.1":       false,
+		"::ffff:169.254.169.254": false,
+		"::ffff:100.64.0.1":      false,
+		"::ffff:192.0.0.1":       false,
+		"::ffff:0.0.0.1":         false,
+		"::10.0.0.1":             false,
+		"64:ff9b::a00:1":         false,
+		"2002:a00:1::1":          false,
+		"fec0::1":                false,
+		"ff02::1":                false,
+		"::ffff:8.8.8.8":         true,
+		"8.8.8.8":                true,
+	} {
+		assert.Equal(t, public, isPublicIP(net.ParseIP(addr)), addr)
+	}
+
+	assert.NoError(t, dialControl("tcp", "1.1.1.1:443", nil))
+	assert.Error(t, dialControl("tcp", "169.254.169.254:80", nil))
+	assert.Error(t, dialControl("tcp6", "[::1]:80", nil))
+
+	// a server on loopback can't be fetched from
+	ts := httptest.NewServer(http.NotFoundHandler())
+	defer ts.Close()
+	_, err := httpClient.Get(ts.URL)
+	assert.Error(t, err)
+}
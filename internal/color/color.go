@@ -1,14 +1,21 @@
 package color
 
-import colorful "github.com/lucasb-eyer/go-colorful"
+import (
+	"fmt"
+	"math"
 
+	colorful "github.com/lucasb-eyer/go-colorful"
+)
+
+// Nearest returns the index of the mIRC palette color closest to the given
+// 0xRRGGBB color.
 func Nearest(hex uint32) int {
 	target := colorful.Color{
-		R: float64(hex>>0&0xFF) / float64(0xFF),
+		R: float64(hex>>16&0xFF) / float64(0xFF),
 		G: float64(hex>>8&0xFF) / float64(0xFF),
-		B: float64(hex>>16&0xFF) / float64(0xFF),
+		B: float64(hex>>0&0xFF) / float64(0xFF),
 	}
-	maxDistance := 1.0
+	maxDistance := math.Inf(1)
 	var result int
 	for i, irc := range colors {
 		if distance := target.DistanceLab(irc); distance < maxDistance {
@@ -19,104 +26,110 @@ func Nearest(hex uint32) int {
 	return result
 }
 
+// HexCode returns the \x04 formatting code for a 0xRRGGBB color, as used by
+// clients supporting the hex color extension.
+func HexCode(hex uint32) string {
+	return fmt.Sprintf("\x04%06X", hex&0xFFFFFF)
+}
+
 var colors = [...]colorful.Color{
-	{R: 0xff / 0xFF, G: 0xff / 0xFF, B: 0xff / 0xFF},
-	{R: 0x00 / 0xFF, G: 0x00 / 0xFF, B: 0x00 / 0xFF},
-	{R: 0x00 / 0xFF, G: 0x00 / 0xFF, B: 0x7f / 0xFF},
-	{R: 0x00 / 0xFF, G: 0x93 / 0xFF, B: 0x00 / 0xFF},
-	{R: 0xff / 0xFF, G: 0x00 / 0xFF, B: 0x00 / 0xFF},
-	{R: 0x7f / 0xFF, G: 0x00 / 0xFF, B: 0x00 / 0xFF},
-	{R: 0x9c / 0xFF, G: 0x00 / 0xFF, B: 0x9c / 0xFF},
-	{R: 0xfc / 0xFF, G: 0x7f / 0xFF, B: 0x00 / 0xFF},
-	{R: 0xff / 0xFF, G: 0xff / 0xFF, B: 0x00 / 0xFF},
-	{R: 0x00 / 0xFF, G: 0xfc / 0xFF, B: 0x00 / 0xFF},
-	{R: 0x00 / 0xFF, G: 0x93 / 0xFF, B: 0x93 / 0xFF},
-	{R: 0x00 / 0xFF, G: 0xff / 0xFF, B: 0xff / 0xFF},
-	{R: 0x00 / 0xFF, G: 0x00 / 0xFF, B: 0xfc / 0xFF},
-	{R: 0xff / 0xFF, G: 0x00 / 0xFF, B: 0xff / 0xFF},
-	{R: 0x7f / 0xFF, G: 0x7f / 0xFF, B: 0x7f / 0xFF},
-	{R: 0xd2 / 0xFF, G: 0xd2 / 0xFF, B: 0xd2 / 0xFF},
-	{R: 0x47 / 0xFF, G: 0x00 / 0xFF, B: 0x00 / 0xFF},
-	{R: 0x47 / 0xFF, G: 0x21 / 0xFF, B: 0x00 / 0xFF},
-	{R: 0x47 / 0xFF, G: 0x47 / 0xFF, B: 0x00 / 0xFF},
-	{R: 0x32 / 0xFF, G: 0x47 / 0xFF, B: 0x00 / 0xFF},
-	{R: 0x00 / 0xFF, G: 0x47 / 0xFF, B: 0x00 / 0xFF},
-	{R: 0x00 / 0xFF, G: 0x47 / 0xFF, B: 0x2c / 0xFF},
-	{R: 0x00 / 0xFF, G: 0x47 / 0xFF, B: 0x47 / 0xFF},
-	{R: 0x00 / 0xFF, G: 0x27 / 0xFF, B: 0x47 / 0xFF},
-	{R: 0x00 / 0xFF, G: 0x00 / 0xFF, B: 0x47 / 0xFF},
-	{R: 0x2e / 0xFF, G: 0x00 / 0xFF, B: 0x47 / 0xFF},
-	{R: 0x47 / 0xFF, G: 0x00 / 0xFF, B: 0x47 / 0xFF},
-	{R: 0x47 / 0xFF, G: 0x00 / 0xFF, B: 0x2a / 0xFF},
-	{R: 0x74 / 0xFF, G: 0x00 / 0xFF, B: 0x00 / 0xFF},
-	{R: 0x74 / 0xFF, G: 0x3a / 0xFF, B: 0x00 / 0xFF},
-	{R: 0x74 / 0xFF, G: 0x74 / 0xFF, B: 0x00 / 0xFF},
-	{R: 0x51 / 0xFF, G: 0x74 / 0xFF, B: 0x00 / 0xFF},
-	{R: 0x00 / 0xFF, G: 0x74 / 0xFF, B: 0x00 / 0xFF},
-	{R: 0x00 / 0xFF, G: 0x74 / 0xFF, B: 0x49 / 0xFF},
-	{R: 0x00 / 0xFF, G: 0x74 / 0xFF, B: 0x74 / 0xFF},
-	{R: 0x00 / 0xFF, G: 0x40 / 0xFF, B: 0x74 / 0xFF},
-	{R: 0x00 / 0xFF, G: 0x00 / 0xFF, B: 0x74 / 0xFF},
-	{R: 0x4b / 0xFF, G: 0x00 / 0xFF, B: 0x74 / 0xFF},
-	{R: 0x74 / 0xFF, G: 0x00 / 0xFF, B: 0x74 / 0xFF},
-	{R: 0x74 / 0xFF, G: 0x00 / 0xFF, B: 0x45 / 0xFF},
-	{R: 0xb5 / 0xFF, G: 0x00 / 0xFF, B: 0x00 / 0xFF},
-	{R: 0xb5 / 0xFF, G: 0x63 / 0xFF, B: 0x00 / 0xFF},
-	{R: 0xb5 / 0xFF, G: 0xb5 / 0xFF, B: 0x00 / 0xFF},
-	{R: 0x7d / 0xFF, G: 0xb5 / 0xFF, B: 0x00 / 0xFF},
-	{R: 0x00 / 0xFF, G: 0xb5 / 0xFF, B: 0x00 / 0xFF},
-	{R: 0x00 / 0xFF, G: 0xb5 / 0xFF, B: 0x71 / 0xFF},
-	{R: 0x00 / 0xFF, G: 0xb5 / 0xFF, B: 0xb5 / 0xFF},
-	{R: 0x00 / 0xFF, G: 0x63 / 0xFF, B: 0xb5 / 0xFF},
-	{R: 0x00 / 0xFF, G: 0x00 / 0xFF, B: 0xb5 / 0xFF},
-	{R: 0x75 / 0xFF, G: 0x00 / 0xFF, B: 0xb5 / 0xFF},
-	{R: 0xb5 / 0xFF, G: 0x00 / 0xFF, B: 0xb5 / 0xFF},
-	{R: 0xb5 / 0xFF, G: 0x00 / 0xFF, B: 0x6b / 0xFF},
-	{R: 0xff / 0xFF, G: 0x00 / 0xFF, B: 0x00 / 0xFF},
-	{R: 0xff / 0xFF, G: 0x8c / 0xFF, B: 0x00 / 0xFF},
-	{R: 0xff / 0xFF, G: 0xff / 0xFF, B: 0x00 / 0xFF},
-	{R: 0xb2 / 0xFF, G: 0xff / 0xFF, B: 0x00 / 0xFF},
-	{R: 0x00 / 0xFF, G: 0xff / 0xFF, B: 0x00 / 0xFF},
-	{R: 0x00 / 0xFF, G: 0xff / 0xFF, B: 0xa0 / 0xFF},
-	{R: 0x00 / 0xFF, G: 0xff / 0xFF, B: 0xff / 0xFF},
-	{R: 0x00 / 0xFF, G: 0x8c / 0xFF, B: 0xff / 0xFF},
-	{R: 0x00 / 0xFF, G: 0x00 / 0xFF, B: 0xff / 0xFF},
-	{R: 0xa5 / 0xFF, G: 0x00 / 0xFF, B: 0xff / 0xFF},
-	{R: 0xff / 0xFF, G: 0x00 / 0xFF, B: 0xff / 0xFF},
-	{R: 0xff / 0xFF, G: 0x00 / 0xFF, B: 0x98 / 0xFF},
-	{R: 0xff / 0xFF, G: 0x59 / 0xFF, B: 0x59 / 0xFF},
-	{R: 0xff / 0xFF, G: 0xb4 / 0xFF, B: 0x59 / 0xFF},
-	{R: 0xff / 0xFF, G: 0xff / 0xFF, B: 0x71 / 0xFF},
-	{R: 0xcf / 0xFF, G: 0xff / 0xFF, B: 0x60 / 0xFF},
-	{R: 0x6f / 0xFF, G: 0xff / 0xFF, B: 0x6f / 0xFF},
-	{R: 0x65 / 0xFF, G: 0xff / 0xFF, B: 0xc9 / 0xFF},
-	{R: 0x6d / 0xFF, G: 0xff / 0xFF, B: 0xff / 0xFF},
-	{R: 0x59 / 0xFF, G: 0xb4 / 0xFF, B: 0xff / 0xFF},
-	{R: 0x59 / 0xFF, G: 0x59 / 0xFF, B: 0xff / 0xFF},
-	{R: 0xc4 / 0xFF, G: 0x59 / 0xFF, B: 0xff / 0xFF},
-	{R: 0xff / 0xFF, G: 0x66 / 0xFF, B: 0xff / 0xFF},
-	{R: 0xff / 0xFF, G: 0x59 / 0xFF, B: 0xbc / 0xFF},
-	{R: 0xff / 0xFF, G: 0x9c / 0xFF, B: 0x9c / 0xFF},
-	{R: 0xff / 0xFF, G: 0xd3 / 0xFF, B: 0x9c / 0xFF},
-	{R: 0xff / 0xFF, G: 0xff / 0xFF, B: 0x9c / 0xFF},
-	{R: 0xe2 / 0xFF, G: 0xff / 0xFF, B: 0x9c / 0xFF},
-	{R: 0x9c / 0xFF, G: 0xff / 0xFF, B: 0x9c / 0xFF},
-	{R: 0x9c / 0xFF, G: 0xff / 0xFF, B: 0xdb / 0xFF},
-	{R: 0x9c / 0xFF, G: 0xff / 0xFF, B: 0xff / 0xFF},
-	{R: 0x9c / 0xFF, G: 0xd3 / 0xFF, B: 0xff / 0xFF},
-	{R: 0x9c / 0xFF, G: 0x9c / 0xFF, B: 0xff / 0xFF},
-	{R: 0xdc / 0xFF, G: 0x9c / 0xFF, B: 0xff / 0xFF},
-	{R: 0xff / 0xFF, G: 0x9c / 0xFF, B: 0xff / 0xFF},
-	{R: 0xff / 0xFF, G: 0x94 / 0xFF, B: 0xd3 / 0xFF},
-	{R: 0x00 / 0xFF, G: 0x00 / 0xFF, B: 0x00 / 0xFF},
-	{R: 0x13 / 0xFF, G: 0x13 / 0xFF, B: 0x13 / 0xFF},
-	{R: 0x28 / 0xFF, G: 0x28 / 0xFF, B: 0x28 / 0xFF},
-	{R: 0x36 / 0xFF, G: 0x36 / 0xFF, B: 0x36 / 0xFF},
-	{R: 0x4d / 0xFF, G: 0x4d / 0xFF, B: 0x4d / 0xFF},
-	{R: 0x65 / 0xFF, G: 0x65 / 0xFF, B: 0x65 / 0xFF},
-	{R: 0x81 / 0xFF, G: 0x81 / 0xFF, B: 0x81 / 0xFF},
-	{R: 0x9f / 0xFF, G: 0x9f / 0xFF, B: 0x9f / 0xFF},
-	{R: 0xbc / 0xFF, G: 0xbc / 0xFF, B: 0xbc / 0xFF},
-	{R: 0xe2 / 0xFF, G: 0xe2 / 0xFF, B: 0xe2 / 0xFF},
-	{R: 0xff / 0xFF, G: 0xff / 0xFF, B: 0xff / 0xFF},
+	{R: 0xff / 255.0, G: 0xff / 255.0, B: 0xff / 255.0},
+	{R: 0x00 / 255.0, G: 0x00 / 255.0, B: 0x00 / 255.0},
+	{R: 0x00 / 255.0, G: 0x00 / 255.0, B: 0x7f / 255.0},
+	{R: 0x00 / 255.0, G: 0x93 / 255.0, B: 0x00 / 255.0},
+	{R: 0xff / 255.0, G: 0x00 / 255.0, B: 0x00 / 255.0},
+	{R: 0x7f / 255.0, G: 0x00 / 255.0, B: 0x00 / 255.0},
+	{R: 0x9c / 255.0, G: 0x00 / 255.0, B: 0x9c / 255.0},
+	{R: 0xfc / 255.0, G: 0x7f / 255.0, B: 0x00 / 255.0},
+	{R: 0xff / 255.0, G: 0xff / 255.0, B: 0x00 / 255.0},
+	{R: 0x00 / 255.0, G: 0xfc / 255.0, B: 0x00 / 255.0},
+	{R: 0x00 / 255.0, G: 0x93 / 255.0, B: 0x93 / 255.0},
+	{R: 0x00 / 255.0, G: 0xff / 255.0, B: 0xff / 255.0},
+	{R: 0x00 / 255.0, G: 0x00 / 255.0, B: 0xfc / 255.0},
+	{R: 0xff / 255.0, G: 0x00 / 255.0, B: 0xff / 255.0},
+	{R: 0x7f / 255.0, G: 0x7f / 255.0, B: 0x7f / 255.0},
+	{R: 0xd2 / 255.0, G: 0xd2 / 255.0, B: 0xd2 / 255.0},
+	{R: 0x47 / 255.0, G: 0x00 / 255.0, B: 0x00 / 255.0},
+	{R: 0x47 / 255.0, G: 0x21 / 255.0, B: 0x00 / 255.0},
+	{R: 0x47 / 255.0, G: 0x47 / 255.0, B: 0x00 / 255.0},
+	{R: 0x32 / 255.0, G: 0x47 / 255.0, B: 0x00 / 255.0},
+	{R: 0x00 / 255.0, G: 0x47 / 255.0, B: 0x00 / 255.0},
+	{R: 0x00 / 255.0, G: 0x47 / 255.0, B: 0x2c / 255.0},
+	{R: 0x00 / 255.0, G: 0x47 / 255.0, B: 0x47 / 255.0},
+	{R: 0x00 / 255.0, G: 0x27 / 255.0, B: 0x47 / 255.0},
+	{R: 0x00 / 255.0, G: 0x00 / 255.0, B: 0x47 / 255.0},
+	{R: 0x2e / 255.0, G: 0x00 / 255.0, B: 0x47 / 255.0},
+	{R: 0x47 / 255.0, G: 0x00 / 255.0, B: 0x47 / 255.0},
+	{R: 0x47 / 255.0, G: 0x00 / 255.0, B: 0x2a / 255.0},
+	{R: 0x74 / 255.0, G: 0x00 / 255.0, B: 0x00 / 255.0},
+	{R: 0x74 / 255.0, G: 0x3a / 255.0, B: 0x00 / 255.0},
+	{R: 0x74 / 255.0, G: 0x74 / 255.0, B: 0x00 / 255.0},
+	{R: 0x51 / 255.0, G: 0x74 / 255.0, B: 0x00 / 255.0},
+	{R: 0x00 / 255.0, G: 0x74 / 255.0, B: 0x00 / 255.0},
+	{R: 0x00 / 255.0, G: 0x74 / 255.0, B: 0x49 / 255.0},
+	{R: 0x00 / 255.0, G: 0x74 / 255.0, B: 0x74 / 255.0},
+	{R: 0x00 / 255.0, G: 0x40 / 255.0, B: 0x74 / 255.0},
+	{R: 0x00 / 255.0, G: 0x00 / 255.0, B: 0x74 / 255.0},
+	{R: 0x4b / 255.0, G: 0x00 / 255.0, B: 0x74 / 255.0},
+	{R: 0x74 / 255.0, G: 0x00 / 255.0, B: 0x74 / 255.0},
+	{R: 0x74 / 255.0, G: 0x00 / 255.0, B: 0x45 / 255.0},
+	{R: 0xb5 / 255.0, G: 0x00 / 255.0, B: 0x00 / 255.0},
+	{R: 0xb5 / 255.0, G: 0x63 / 255.0, B: 0x00 / 255.0},
+	{R: 0xb5 / 255.0, G: 0xb5 / 255.0, B: 0x00 / 255.0},
+	{R: 0x7d / 255.0, G: 0xb5 / 255.0, B: 0x00 / 255.0},
+	{R: 0x00 / 255.0, G: 0xb5 / 255.0, B: 0x00 / 255.0},
+	{R: 0x00 / 255.0, G: 0xb5 / 255.0, B: 0x71 / 255.0},
+	{R: 0x00 / 255.0, G: 0xb5 / 255.0, B: 0xb5 / 255.0},
+	{R: 0x00 / 255.0, G: 0x63 / 255.0, B: 0xb5 / 255.0},
+	{R: 0x00 / 255.0, G: 0x00 / 255.0, B: 0xb5 / 255.0},
+	{R: 0x75 / 255.0, G: 0x00 / 255.0, B: 0xb5 / 255.0},
+	{R: 0xb5 / 255.0, G: 0x00 / 255.0, B: 0xb5 / 255.0},
+	{R: 0xb5 / 255.0, G: 0x00 / 255.0, B: 0x6b / 255.0},
+	{R: 0xff / 255.0, G: 0x00 / 255.0, B: 0x00 / 255.0},
+	{R: 0xff / 255.0, G: 0x8c / 255.0, B: 0x00 / 255.0},
+	{R: 0xff / 255.0, G: 0xff / 255.0, B: 0x00 / 255.0},
+	{R: 0xb2 / 255.0, G: 0xff / 255.0, B: 0x00 / 255.0},
+	{R: 0x00 / 255.0, G: 0xff / 255.0, B: 0x00 / 255.0},
+	{R: 0x00 / 255.0, G: 0xff / 255.0, B: 0xa0 / 255.0},
+	{R: 0x00 / 255.0, G: 0xff / 255.0, B: 0xff / 255.0},
+	{R: 0x00 / 255.0, G: 0x8c / 255.0, B: 0xff / 255.0},
+	{R: 0x00 / 255.0, G: 0x00 / 255.0, B: 0xff / 255.0},
+	{R: 0xa5 / 255.0, G: 0x00 / 255.0, B: 0xff / 255.0},
+	{R: 0xff / 255.0, G: 0x00 / 255.0, B: 0xff / 255.0},
+	{R: 0xff / 255.0, G: 0x00 / 255.0, B: 0x98 / 255.0},
+	{R: 0xff / 255.0, G: 0x59 / 255.0, B: 0x59 / 255.0},
+	{R: 0xff / 255.0, G: 0xb4 / 255.0, B: 0x59 / 255.0},
+	{R: 0xff / 255.0, G: 0xff / 255.0, B: 0x71 / 255.0},
+	{R: 0xcf / 255.0, G: 0xff / 255.0, B: 0x60 / 255.0},
+	{R: 0x6f / 255.0, G: 0xff / 255.0, B: 0x6f / 255.0},
+	{R: 0x65 / 255.0, G: 0xff / 255.0, B: 0xc9 / 255.0},
+	{R: 0x6d / 255.0, G: 0xff / 255.0, B: 0xff / 255.0},
+	{R: 0x59 / 255.0, G: 0xb4 / 255.0, B: 0xff / 255.0},
+	{R: 0x59 / 255.0, G: 0x59 / 255.0, B: 0xff / 255.0},
+	{R: 0xc4 / 255.0, G: 0x59 / 255.0, B: 0xff / 255.0},
+	{R: 0xff / 255.0, G: 0x66 / 255.0, B: 0xff / 255.0},
+	{R: 0xff / 255.0, G: 0x59 / 255.0, B: 0xbc / 255.0},
+	{R: 0xff / 255.0, G: 0x9c / 255.0, B: 0x9c / 255.0},
+	{R: 0xff / 255.0, G: 0xd3 / 255.0, B: 0x9c / 255.0},
+	{R: 0xff / 255.0, G: 0xff / 255.0, B: 0x9c / 255.0},
+	{R: 0xe2 / 255.0, G: 0xff / 255.0, B: 0x9c / 255.0},
+	{R: 0x9c / 255.0, G: 0xff / 255.0, B: 0x9c / 255.0},
+	{R: 0x9c / 255.0, G: 0xff / 255.0, B: 0xdb / 255.0},
+	{R: 0x9c / 255.0, G: 0xff / 255.0, B: 0xff / 255.0},
+	{R: 0x9c / 255.0, G: 0xd3 / 255.0, B: 0xff / 255.0},
+	{R: 0x9c / 255.0, G: 0x9c / 255.0, B: 0xff / 255.0},
+	{R: 0xdc / 255.0, G: 0x9c / 255.0, B: 0xff / 255.0},
+	{R: 0xff / 255.0, G: 0x9c / 255.0, B: 0xff / 255.0},
+	{R: 0xff / 255.0, G: 0x94 / 255.0, B: 0xd3 / 255.0},
+	{R: 0x00 / 255.0, G: 0x00 / 255.0, B: 0x00 / 255.0},
+	{R: 0x13 / 255.0, G: 0x13 / 255.0, B: 0x13 / 255.0},
+	{R: 0x28 / 255.0, G: 0x28 / 255.0, B: 0x28 / 255.0},
+	{R: 0x36 / 255.0, G: 0x36 / 255.0, B: 0x36 / 255.0},
+	{R: 0x4d / 255.0, G: 0x4d / 255.0, B: 0x4d / 255.0},
+	{R: 0x65 / 255.0, G: 0x65 / 255.0, B: 0x65 / 255.0},
+	{R: 0x81 / 255.0, G: 0x81 / 255.0, B: 0x81 / 255.0},
+	{R: 0x9f / 255.0, G: 0x9f / 255.0, B: 0x9f / 255.0},
+	{R: 0xbc / 255.0, G: 0xbc / 255.0, B: 0xbc / 255.0},
+	{R: 0xe2 / 255.0, G: 0xe2 / 255.0, B: 0xe2 / 255.0},
+	{R: 0xff / 255.0, G: 0xff / 255.0, B: 0xff / 255.0},
 }
@@ -0,0 +1,475 @@
+// Package config reads the ircdiscord configuration file.
+//
+// The file is in the scfg format, for example:
+//
+//	listen irc://localhost:6667
+//	listen ircs://0.0.0.0:6697
+//	listen unix:///run/ircdiscord/irc.sock {
+//		mode 0660
+//	}
+//	tls /etc/ircdiscord/cert.pem /etc/ircdiscord/key.pem
+//	accounts /var/lib/ircdiscord/accounts.json
+//	secret-file /etc/ircdiscord/secret
+//	pass-login false
+//	metrics localhost:9090
+//	admin unix:///run/ircdiscord/admin.sock {
+//		mode 0600
+//	}
+//	log {
+//		format json
+//		level info
+//		level irc debug
+//	}
+//	server-name irc.example.com
+//	motd "Welcome to ircdiscord."
+//	backlog 50
+//	render {
+//		theme light
+//		spoilers hidden
+//	}
+//	user 80351110224678912 {
+//		backlog 10
+//		render {
+//			timezone Europe/Berlin
+//		}
+//	}
+package config
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/diamondburned/arikawa/discord"
+	"github.com/tadeokondrak/ircdiscord/internal/render"
+)
+
+// Config is the configuration of an ircdiscord server.
+type Config struct {
+	Listeners     []*Listener
+	TLS           *TLS      // certificate for TLS listeners
+	Accounts      string    // file of accounts to log in to with SASL
+	SecretFile    string    // encrypts the tokens of accounts
+	PassLogin     bool      // whether a Discord token can be given in PASS
+	Metrics       string    // address to serve metrics on, if any
+	Admin         *Listener // where the admin API listens, if anywhere
+	Log           Log
+	ServerName    string
+	ServerVersion string
+	MOTD          []string
+	Backlog       int // messages shown when joining a channel
+	UploadDir     string
+	UploadLimit   int64
+	UploadHTTP    bool // whether files can be uploaded from URLs
+	Render        Render
+	Users         map[discord.Snowflake]*User
+}
+
+// TLS names the certificate and key files for TLS connections.
+type TLS struct {
+	Cert string
+	Key  string
+}
+
+// Render holds rendering settings. Empty settings are left as they are.
+type Render struct {
+	Theme     string
+	Colors    string
+	Previews  string
+	Edits     string
+	Spoilers  string
+	Timezone  string
+	Locale    string
+	EmojiURLs *bool
+}
+
+// User holds settings for one Discord user, which take precedence over the
+// server's.
+type User struct {
+	Backlog *int
+	Render  Render
+}
+
+// DefaultBacklog is the number of messages Discord returns by default.
+const DefaultBacklog = 100
+
+// DefaultUploadLimit is Discord's upload limit for users without Nitro.
+const DefaultUploadLimit = 8 << 20
+
+// Default returns the configuration used when there is no file.
+func Default() *Config {
+	return &Config{
+		ServerName:    "ircdiscord",
+		ServerVersion: "git",
+		Backlog:       DefaultBacklog,
+		UploadLimit:   DefaultUploadLimit,
+		PassLogin:     true,
+		Log:           defaultLog(),
+		Users:         make(map[discord.Snowflake]*User),
+	}
+}
+
+// Load reads and validates the configuration file at path.
+func Load(path string) (*Config, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	cfg, err := parse(f, filepath.Dir(path))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+
+	return cfg, nil
+}
+
+// Parse reads a configuration file, starting from the defaults. Relative
+// paths in it are relative to the working directory.
+func Parse(r io.Reader) (*Config, error) {
+	return parse(r, ".")
+}
+
+func parse(r io.Reader, dir string) (*Config, error) {
+	directives, err := parseScfg(r)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := Default()
+	for _, d := range directives {
+		if err := cfg.parseDirective(d, dir); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, l := range cfg.Listeners {
+		if l.bare {
+			l.TLS = cfg.TLS != nil
+		}
+	}
+
+	return cfg, nil
+}
+
+func (c *Config) parseDirective(d *Directive, dir string) error {
+	switch d.Name {
+	case "listen":
+		var addr string
+		if err := d.parseString(&addr); err != nil {
+			return err
+		}
+		l, err := parseListener(addr, dir)
+		if err != nil {
+			return d.errorf("%v", err)
+		}
+		if err := l.parse(d); err != nil {
+			return err
+		}
+		c.Listeners = append(c.Listeners, l)
+	case "tls":
+		if len(d.Params) != 2 {
+			return d.errorf("expected a certificate and a key file")
+		}
+		c.TLS = &TLS{
+			Cert: resolvePath(dir, d.Params[0]),
+			Key:  resolvePath(dir, d.Params[1]),
+		}
+	case "accounts":
+		var name string
+		if err := d.parseString(&name); err != nil {
+			return err
+		}
+		c.Accounts = resolvePath(dir, name)
+	case "secret-file":
+		var name string
+		if err := d.parseString(&name); err != nil {
+			return err
+		}
+		c.SecretFile = resolvePath(dir, name)
+	case "pass-login":
+		return d.parseBool(&c.PassLogin)
+	case "admin":
+		var addr string
+		if err := d.parseString(&addr); err != nil {
+			return err
+		}
+		l, err := parseAdminListener(addr, dir)
+		if err != nil {
+			return d.errorf("%v", err)
+		}
+		if err := l.parse(d); err != nil {
+			return err
+		}
+		c.Admin = l
+	case "metrics":
+		return d.parseString(&c.Metrics)
+	case "log":
+		return c.Log.parse(d)
+	case "server-name":
+		return d.parseString(&c.ServerName)
+	case "server-version":
+		return d.parseString(&c.ServerVersion)
+	case "motd":
+		c.MOTD = append(c.MOTD, strings.Join(d.Params, " "))
+	case "motd-file":
+		var name string
+		if err := d.parseString(&name); err != nil {
+			return err
+		}
+		data, err := ioutil.ReadFile(resolvePath(dir, name))
+		if err != nil {
+			return d.errorf("%v", err)
+		}
+		c.MOTD = append(c.MOTD,
+			strings.Split(strings.TrimRight(string(data), "\n"), "\n")...)
+	case "backlog":
+		return d.parseInt(&c.Backlog)
+	case "upload-dir":
+		var name string
+		if err := d.parseString(&name); err != nil {
+			return err
+		}
+		c.UploadDir = resolvePath(dir, name)
+	case "upload-http":
+		return d.parseBool(&c.UploadHTTP)
+	case "upload-limit":
+		var limit int
+		if err := d.parseInt(&limit); err != nil {
+			return err
+		}
+		c.UploadLimit = int64(limit)
+	case "render":
+		return c.Render.parse(d, dir)
+	case "user":
+		if len(d.Params) != 1 {
+			return d.errorf("expected a Discord user ID")
+		}
+		id, err := discord.ParseSnowflake(d.Params[0])
+		if err != nil {
+			return d.errorf("invalid user ID %s", d.Params[0])
+		}
+		user, ok := c.Users[id]
+		if !ok {
+			user = &User{}
+			c.Users[id] = user
+		}
+		return user.parse(d, dir)
+	default:
+		return d.errorf("unknown directive")
+	}
+	return nil
+}
+
+func (u *User) parse(d *Directive, dir string) error {
+	for _, child := range d.Children {
+		switch child.Name {
+		case "backlog":
+			var backlog int
+			if err := child.parseInt(&backlog); err != nil {
+				return err
+			}
+			u.Backlog = &backlog
+		case "render":
+			if err := u.Render.parse(child, dir); err != nil {
+				return err
+			}
+		default:
+			return child.errorf("unknown directive")
+		}
+	}
+	return nil
+}
+
+func (r *Render) parse(d *Directive, dir string) error {
+	for _, child := range d.Children {
+		var err error
+		switch child.Name {
+		case "theme":
+			err = child.parseString(&r.Theme)
+			if _, builtin := render.Themes[r.Theme]; !builtin {
+				// a theme file
+				r.Theme = resolvePath(dir, r.Theme)
+			}
+		case "colors":
+			err = child.parseString(&r.Colors)
+		case "previews":
+			err = child.parseString(&r.Previews)
+		case "edits":
+			err = child.parseString(&r.Edits)
+		case "spoilers":
+			err = child.parseString(&r.Spoilers)
+		case "timezone":
+			err = child.parseString(&r.Timezone)
+		case "locale":
+			err = child.parseString(&r.Locale)
+		case "emoji-urls":
+			var b bool
+			err = child.parseBool(&b)
+			r.EmojiURLs = &b
+		default:
+			err = child.errorf("unknown directive")
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Apply sets the options that r changes.
+func (r *Render) Apply(opts *render.Options) error {
+	if r.Theme != "" {
+		theme, err := render.LoadTheme(r.Theme)
+		if err != nil {
+			return err
+		}
+		opts.Theme = theme
+	}
+	if r.Colors != "" {
+		mode, err := render.ParseColorMode(r.Colors)
+		if err != nil {
+			return err
+		}
+		opts.Colors = mode
+	}
+	if r.Previews != "" {
+		mode, err := render.ParsePreviewMode(r.Previews)
+		if err != nil {
+			return err
+		}
+		opts.LinkPreviews = mode
+	}
+	if r.Edits != "" {
+		mode, err := render.ParseEditMode(r.Edits)
+		if err != nil {
+			return err
+		}
+		opts.Edits = mode
+	}
+	if r.Spoilers != "" {
+		mode, err := render.ParseSpoilerMode(r.Spoilers)
+		if err != nil {
+			return err
+		}
+		opts.Spoilers = mode
+	}
+	if r.Timezone != "" {
+		loc, err := time.LoadLocation(r.Timezone)
+		if err != nil {
+			return err
+		}
+		opts.Location = loc
+	}
+	if r.Locale != "" {
+		if _, ok := render.Locales[r.Locale]; !ok {
+			return fmt.Errorf("unknown locale %s", r.Locale)
+		}
+		opts.Locale = r.Locale
+	}
+	if r.EmojiURLs != nil {
+		opts.EmojiURLs = *r.EmojiURLs
+	}
+	return nil
+}
+
+// Validate checks that the configuration can be used.
+func (c *Config) Validate() error {
+	if c.TLS != nil && (c.TLS.Cert == "" || c.TLS.Key == "") {
+		return fmt.Errorf("tls needs both a certificate and a key")
+	}
+	for _, l := range c.Listeners {
+		if l.TLS && c.TLS == nil {
+			return fmt.Errorf("listener %v needs a tls certificate", l)
+		}
+	}
+	if !c.PassLogin && c.Accounts == "" {
+		return fmt.Errorf("pass-login can only be disabled with accounts")
+	}
+	if c.Backlog < 0 {
+		return fmt.Errorf("backlog can't be negative")
+	}
+	if c.UploadLimit <= 0 {
+		return fmt.Errorf("upload-limit must be positive")
+	}
+	if c.UploadDir != "" {
+		if info, err := os.Stat(c.UploadDir); err != nil {
+			return err
+		} else if !info.IsDir() {
+			return fmt.Errorf("upload-dir %s is not a directory",
+				c.UploadDir)
+		}
+	}
+
+	if err := c.Render.Apply(render.DefaultOptions()); err != nil {
+		return fmt.Errorf("render: %v", err)
+	}
+
+	for id, user := range c.Users {
+		if user.Backlog != nil && *user.Backlog < 0 {
+			return fmt.Errorf("user %s: backlog can't be negative", id)
+		}
+		if err := user.Render.Apply(render.DefaultOptions()); err != nil {
+			return fmt.Errorf("user %s: render: %v", id, err)
+		}
+	}
+
+	return nil
+}
+
+func resolvePath(dir, name string) string {
+	if filepath.IsAbs(name) {
+		return name
+	}
+	return filepath.Join(dir, name)
+}
+
+func (d *Directive) parseString(s *string) error {
+	if len(d.Params) != 1 {
+		return d.errorf("expected one parameter")
+	}
+	*s = d.Params[0]
+	return nil
+}
+
+func (d *Directive) parseInt(n *int) error {
+	var s string
+	if err := d.parseString(&s); err != nil {
+		return err
+	}
+	i, err := strconv.Atoi(s)
+	if err != nil {
+		return d.errorf("invalid number %s", s)
+	}
+	*n = i
+	return nil
+}
+
+// parseBool parses a directive that is either on its own, meaning true, or
+// followed by true or false.
+func (d *Directive) parseBool(b *bool) error {
+	if len(d.Params) == 0 {
+		*b = true
+		return nil
+	}
+	var s string
+	if err := d.parseString(&s); err != nil {
+		return err
+	}
+	v, err := strconv.ParseBool(s)
+	if err != nil {
+		return d.errorf("expected true or false, not %s", s)
+	}
+	*b = v
+	return nil
+}
@@ -0,0 +1,124 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/diamondburned/arikawa/discord"
+	"github.com/stretchr/testify/assert"
+	"github.com/tadeokondrak/ircdiscord/internal/logging"
+)
+
+const example = `
+# comments and blank lines are skipped
+listen :6697
+listen irc://localhost
+listen unix://irc.sock {
+	mode 0660
+}
+tls cert.pem "my key.pem"
+server-name irc.example.com
+motd "Welcome to ircdiscord."
+motd second\ line
+backlog 50
+metrics localhost:9090
+admin 127.0.0.1:6000
+log {
+	format json
+	level warn
+	level irc debug
+	level client debug
+}
+render {
+	theme light
+	spoilers hidden
+	emoji-urls
+}
+user 80351110224678912 {
+	backlog 10
+	render {
+		timezone Europe/Berlin
+		emoji-urls false
+	}
+}
+`
+
+func TestParse(t *testing.T) {
+	cfg, err := Parse(strings.NewReader(example))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, []*Listener{
+		{Network: "tcp", Address: ":6697", TLS: true, bare: true},
+		{Network: "tcp", Address: "localhost:6667"},
+		{Network: "unix", Address: "irc.sock", Mode: 0660},
+	}, cfg.Listeners)
+	assert.Equal(t, &TLS{Cert: "cert.pem", Key: "my key.pem"}, cfg.TLS)
+	assert.Equal(t, "irc.example.com", cfg.ServerName)
+	assert.Equal(t, "git", cfg.ServerVersion)
+	assert.Equal(t,
+		[]string{"Welcome to ircdiscord.", "second line"}, cfg.MOTD)
+	assert.Equal(t, 50, cfg.Backlog)
+	assert.Equal(t, "localhost:9090", cfg.Metrics)
+	assert.Equal(t, &Listener{Network: "tcp", Address: "127.0.0.1:6000"},
+		cfg.Admin)
+	assert.Equal(t, Log{
+		Format: logging.JSON,
+		Level:  logging.Warn,
+		Levels: map[string]logging.Level{
+			"irc":     logging.Debug,
+			"discord": logging.Info,
+			"client":  logging.Debug,
+		},
+	}, cfg.Log)
+	assert.Equal(t, "light", cfg.Render.Theme)
+	assert.Equal(t, "hidden", cfg.Render.Spoilers)
+	assert.True(t, *cfg.Render.EmojiURLs)
+
+	user := cfg.Users[discord.Snowflake(80351110224678912)]
+	if assert.NotNil(t, user) {
+		assert.Equal(t, 10, *user.Backlog)
+		assert.Equal(t, "Europe/Berlin", user.Render.Timezone)
+		assert.False(t, *user.Render.EmojiURLs)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for input, want := range map[string]string{
+		"listen http://localhost":        "line 1: listen: unknown scheme http",
+		"listen :6697 {\n\tmode 0600\n}": "line 2: mode: only Unix sockets have a mode",
+		"admin 0.0.0.0:6000":             "line 1: admin: the admin API can only listen on localhost or a Unix socket",
+		"bogus":                          "line 1: bogus: unknown directive",
+		"backlog many":                   "line 1: backlog: invalid number many",
+		"render {\ntheme dark":           "line 1: unclosed block for render",
+		"}":                              "line 1: unexpected }",
+		"motd \"unterminated":            "line 1: unterminated quote",
+		"tls cert.pem":                   "line 1: tls: expected a certificate and a key file",
+		"user someone {\n}":              "line 1: user: invalid user ID someone",
+		"render {\n\tcolor red\n}":       "line 2: color: unknown directive",
+		"log {\n\tlevel loud\n}":         "line 2: level: unknown log level loud",
+	} {
+		_, err := Parse(strings.NewReader(input))
+		if assert.Error(t, err, input) {
+			assert.Equal(t, want, err.Error(), input)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	cfg, err := Parse(strings.NewReader("render {\n\tspoilers loud\n}"))
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.EqualError(t, cfg.Validate(),
+		"render: unknown spoiler mode loud")
+
+	cfg, err = Parse(strings.NewReader("listen ircs://"))
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, ":6697", cfg.Listeners[0].Address)
+	assert.EqualError(t, cfg.Validate(),
+		"listener ircs://:6697 needs a tls certificate")
+}
@@ -0,0 +1,131 @@
+package config
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Listener is an address to accept IRC connections on.
+type Listener struct {
+	Network string // "tcp" or "unix"
+	Address string
+	TLS     bool
+	// Mode holds the permission bits of a Unix socket, or 0 to leave them
+	// as the umask sets them.
+	Mode os.FileMode
+
+	// bare is set for a plain address, whose TLS setting follows whether
+	// the tls directive is given
+	bare bool
+}
+
+// DefaultListener returns the listener used when none are configured.
+func DefaultListener(tls bool) *Listener {
+	if tls {
+		return &Listener{Network: "tcp", Address: ":6697", TLS: true}
+	}
+	return &Listener{Network: "tcp", Address: ":6667"}
+}
+
+// String returns the listener in the form it is written in the configuration
+// file.
+func (l *Listener) String() string {
+	switch {
+	case l.Network == "unix":
+		return "unix://" + l.Address
+	case l.TLS:
+		return "ircs://" + l.Address
+	default:
+		return "irc://" + l.Address
+	}
+}
+
+// parseListener parses the address of a listen directive, one of
+// irc://host:port, ircs://host:port, unix:///path or a plain host:port.
+func parseListener(s, dir string) (*Listener, error) {
+	if !strings.Contains(s, "://") {
+		if _, _, err := net.SplitHostPort(s); err != nil {
+			return nil, err
+		}
+		return &Listener{Network: "tcp", Address: s, bare: true}, nil
+	}
+
+	if strings.HasPrefix(s, "unix://") {
+		path := strings.TrimPrefix(s, "unix://")
+		if path == "" {
+			return nil, fmt.Errorf("missing socket path")
+		}
+		return &Listener{Network: "unix", Address: resolvePath(dir, path)},
+			nil
+	}
+
+	u, err := url.Parse(s)
+	if err != nil {
+		return nil, err
+	}
+
+	var l *Listener
+	switch u.Scheme {
+	case "irc":
+		l = &Listener{Network: "tcp"}
+	case "ircs":
+		l = &Listener{Network: "tcp", TLS: true}
+	default:
+		return nil, fmt.Errorf("unknown scheme %s", u.Scheme)
+	}
+
+	l.Address = u.Host
+	if u.Port() == "" {
+		l.Address = net.JoinHostPort(u.Hostname(),
+			DefaultListener(l.TLS).Address[1:])
+	}
+
+	return l, nil
+}
+
+// parseAdminListener parses the address of the admin directive, either
+// unix:///path or host:port on the loopback interface.
+func parseAdminListener(s, dir string) (*Listener, error) {
+	if strings.HasPrefix(s, "unix://") {
+		return parseListener(s, dir)
+	}
+
+	host, _, err := net.SplitHostPort(s)
+	if err != nil {
+		return nil, err
+	}
+	if ip := net.ParseIP(host); host != "localhost" &&
+		(ip == nil || !ip.IsLoopback()) {
+		return nil, fmt.Errorf("the admin API can only listen on " +
+			"localhost or a Unix socket")
+	}
+
+	return &Listener{Network: "tcp", Address: s}, nil
+}
+
+func (l *Listener) parse(d *Directive) error {
+	for _, child := range d.Children {
+		switch child.Name {
+		case "mode":
+			if l.Network != "unix" {
+				return child.errorf("only Unix sockets have a mode")
+			}
+			var s string
+			if err := child.parseString(&s); err != nil {
+				return err
+			}
+			mode, err := strconv.ParseUint(s, 8, 32)
+			if err != nil || mode&^uint64(os.ModePerm) != 0 {
+				return child.errorf("invalid mode %s", s)
+			}
+			l.Mode = os.FileMode(mode)
+		default:
+			return child.errorf("unknown directive")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package config
+
+import "github.com/tadeokondrak/ircdiscord/internal/logging"
+
+// Log holds logging settings.
+type Log struct {
+	Format logging.Format
+	Level  logging.Level
+	// Levels holds the levels of subsystems, overriding Level.
+	Levels map[string]logging.Level
+}
+
+// defaultLog logs at info level. The irc and discord subsystems only log
+// every line sent at debug level, so they stay at info until they are given
+// their own level.
+func defaultLog() Log {
+	return Log{
+		Level: logging.Info,
+		Levels: map[string]logging.Level{
+			"irc":     logging.Info,
+			"discord": logging.Info,
+		},
+	}
+}
+
+// Options returns the options to create a logging.Logger with.
+func (l *Log) Options() logging.Options {
+	return logging.Options{
+		Format: l.Format,
+		Level:  l.Level,
+		Levels: l.Levels,
+	}
+}
+
+func (l *Log) parse(d *Directive) error {
+	for _, child := range d.Children {
+		switch child.Name {
+		case "format":
+			var s string
+			if err := child.parseString(&s); err != nil {
+				return err
+			}
+			format, err := logging.ParseFormat(s)
+			if err != nil {
+				return child.errorf("%v", err)
+			}
+			l.Format = format
+		case "level":
+			// either a level, or a subsystem and its level
+			var subsystem, s string
+			switch len(child.Params) {
+			case 1:
+				s = child.Params[0]
+			case 2:
+				subsystem, s = child.Params[0], child.Params[1]
+			default:
+				return child.errorf("expected a level, " +
+					"optionally after a subsystem")
+			}
+			level, err := logging.ParseLevel(s)
+			if err != nil {
+				return child.errorf("%v", err)
+			}
+			if subsystem == "" {
+				l.Level = level
+			} else {
+				l.Levels[subsystem] = level
+			}
+		default:
+			return child.errorf("unknown directive")
+		}
+	}
+	return nil
+}
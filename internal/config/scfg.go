@@ -0,0 +1,147 @@
+package config
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Directive is a line of an scfg file: a name, its parameters, and the
+// directives in the block that follows it, if any.
+//
+// See https://git.sr.ht/~emersion/scfg for the format.
+type Directive struct {
+	Name     string
+	Params   []string
+	Children []*Directive
+	Line     int
+}
+
+// errorf returns an error mentioning the line of the directive.
+func (d *Directive) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("line %d: %s: %s", d.Line, d.Name,
+		fmt.Sprintf(format, args...))
+}
+
+// parseScfg reads the directives of an scfg file.
+func parseScfg(r io.Reader) ([]*Directive, error) {
+	scanner := bufio.NewScanner(r)
+
+	var stack [][]*Directive
+	var parents []*Directive
+	var current []*Directive
+
+	lineno := 0
+	for scanner.Scan() {
+		lineno++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if line == "}" {
+			if len(parents) == 0 {
+				return nil, fmt.Errorf("line %d: unexpected }", lineno)
+			}
+			parent := parents[len(parents)-1]
+			parent.Children = current
+			current = stack[len(stack)-1]
+			parents = parents[:len(parents)-1]
+			stack = stack[:len(stack)-1]
+			continue
+		}
+
+		words, err := splitWords(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", lineno, err)
+		}
+
+		opensBlock := false
+		if len(words) > 0 && words[len(words)-1] == "{" {
+			opensBlock = true
+			words = words[:len(words)-1]
+		}
+		if len(words) == 0 {
+			return nil, fmt.Errorf("line %d: missing directive name",
+				lineno)
+		}
+
+		d := &Directive{Name: words[0], Params: words[1:], Line: lineno}
+		current = append(current, d)
+
+		if opensBlock {
+			stack = append(stack, current)
+			parents = append(parents, d)
+			current = nil
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(parents) != 0 {
+		return nil, fmt.Errorf("line %d: unclosed block for %s",
+			parents[len(parents)-1].Line, parents[len(parents)-1].Name)
+	}
+
+	return current, nil
+}
+
+// splitWords splits a line into words separated by whitespace. Words can be
+// quoted with double or single quotes, and a backslash escapes the next
+// character outside of single quotes.
+func splitWords(line string) ([]string, error) {
+	var words []string
+	var word strings.Builder
+	inWord := false
+	var quote rune
+
+	runes := []rune(line)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case quote == '\'':
+			if r == '\'' {
+				quote = 0
+			} else {
+				word.WriteRune(r)
+			}
+		case r == '\\':
+			i++
+			if i == len(runes) {
+				return nil, fmt.Errorf("trailing backslash")
+			}
+			word.WriteRune(runes[i])
+			inWord = true
+		case quote == '"':
+			if r == '"' {
+				quote = 0
+			} else {
+				word.WriteRune(r)
+			}
+		case r == '"' || r == '\'':
+			quote = r
+			inWord = true
+		case r == ' ' || r == '\t':
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+		default:
+			word.WriteRune(r)
+			inWord = true
+		}
+	}
+
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated quote")
+	}
+	if inWord {
+		words = append(words, word.String())
+	}
+
+	return words, nil
+}
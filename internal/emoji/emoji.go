@@ -0,0 +1,161 @@
+// Package emoji maps the :shortcodes: used by Discord to Unicode emoji.
+package emoji
+
+//go:generate go run gen.go
+
+// Lookup returns the emoji for a shortcode given without colons.
+func Lookup(shortcode string) (string, bool) {
+	if emoji, ok := aliases[shortcode]; ok {
+		return emoji, true
+	}
+	emoji, ok := names[shortcode]
+	return emoji, ok
+}
+
+// aliases holds Discord's names for common emoji where they differ from
+// the ones made from Unicode names.
+var aliases = map[string]string{
+	"+1":                         "👍",
+	"thumbsup":                   "👍",
+	"-1":                         "👎",
+	"thumbsdown":                 "👎",
+	"ok_hand":                    "👌",
+	"wave":                       "👋",
+	"clap":                       "👏",
+	"pray":                       "🙏",
+	"muscle":                     "💪",
+	"raised_hands":               "🙌",
+	"point_up":                   "☝️",
+	"point_down":                 "👇",
+	"point_left":                 "👈",
+	"point_right":                "👉",
+	"v":                          "✌️",
+	"fingers_crossed":            "🤞",
+	"smile":                      "😄",
+	"smiley":                     "😃",
+	"grinning":                   "😀",
+	"grin":                       "😁",
+	"laughing":                   "😆",
+	"sweat_smile":                "😅",
+	"joy":                        "😂",
+	"rofl":                       "🤣",
+	"slight_smile":               "🙂",
+	"upside_down":                "🙃",
+	"wink":                       "😉",
+	"blush":                      "😊",
+	"innocent":                   "😇",
+	"heart_eyes":                 "😍",
+	"kissing_heart":              "😘",
+	"yum":                        "😋",
+	"stuck_out_tongue":           "😛",
+	"thinking":                   "🤔",
+	"expressionless":             "😑",
+	"no_mouth":                   "😶",
+	"smirk":                      "😏",
+	"unamused":                   "😒",
+	"rolling_eyes":               "🙄",
+	"grimacing":                  "😬",
+	"relieved":                   "😌",
+	"pensive":                    "😔",
+	"sleepy":                     "😪",
+	"sleeping":                   "😴",
+	"mask":                       "😷",
+	"nerd":                       "🤓",
+	"sunglasses":                 "😎",
+	"confused":                   "😕",
+	"worried":                    "😟",
+	"slight_frown":               "🙁",
+	"frowning":                   "😦",
+	"open_mouth":                 "😮",
+	"astonished":                 "😲",
+	"flushed":                    "😳",
+	"cry":                        "😢",
+	"sob":                        "😭",
+	"scream":                     "😱",
+	"confounded":                 "😖",
+	"persevere":                  "😣",
+	"disappointed":               "😞",
+	"sweat":                      "😓",
+	"weary":                      "😩",
+	"triumph":                    "😤",
+	"rage":                       "😡",
+	"angry":                      "😠",
+	"poop":                       "💩",
+	"clown":                      "🤡",
+	"smiley_cat":                 "😺",
+	"see_no_evil":                "🙈",
+	"hear_no_evil":               "🙉",
+	"speak_no_evil":              "🙊",
+	"hugging":                    "🤗",
+	"shrug":                      "🤷",
+	"facepalm":                   "🤦",
+	"100":                        "💯",
+	"heart":                      "❤️",
+	"star2":                      "🌟",
+	"zap":                        "⚡",
+	"boom":                       "💥",
+	"tada":                       "🎉",
+	"gift":                       "🎁",
+	"medal":                      "🏅",
+	"gem":                        "💎",
+	"x":                          "❌",
+	"white_check_mark":           "✅",
+	"heavy_check_mark":           "✔️",
+	"ballot_box_with_check":      "☑️",
+	"question":                   "❓",
+	"exclamation":                "❗",
+	"bangbang":                   "‼️",
+	"interrobang":                "⁉️",
+	"arrow_up":                   "⬆️",
+	"arrow_down":                 "⬇️",
+	"arrow_left":                 "⬅️",
+	"arrow_right":                "➡️",
+	"recycle":                    "♻️",
+	"lock":                       "🔒",
+	"unlock":                     "🔓",
+	"mag":                        "🔍",
+	"bulb":                       "💡",
+	"pencil":                     "📝",
+	"book":                       "📖",
+	"tools":                      "🛠️",
+	"computer":                   "💻",
+	"phone":                      "📱",
+	"email":                      "📧",
+	"hourglass":                  "⌛",
+	"coffee":                     "☕",
+	"tea":                        "🍵",
+	"beer":                       "🍺",
+	"beers":                      "🍻",
+	"fries":                      "🍟",
+	"cake":                       "🍰",
+	"apple":                      "🍎",
+	"sunny":                      "☀️",
+	"earth_americas":             "🌎",
+	"earth_africa":               "🌍",
+	"earth_asia":                 "🌏",
+	"moon":                       "🌙",
+	"dog":                        "🐶",
+	"cat":                        "🐱",
+	"mouse":                      "🐭",
+	"rabbit":                     "🐰",
+	"panda_face":                 "🐼",
+	"whale":                      "🐳",
+	"bee":                        "🐝",
+	"checkered_flag":             "🏁",
+	"triangular_flag_on_post":    "🚩",
+	"notes":                      "🎶",
+	"dart":                       "🎯",
+	"soccer":                     "⚽",
+	"moneybag":                   "💰",
+	"dollar":                     "💵",
+	"chart_with_upwards_trend":   "📈",
+	"chart_with_downwards_trend": "📉",
+	"salute":                     "🫡",
+	"zipper_mouth":               "🤐",
+	"money_mouth":                "🤑",
+	"cowboy":                     "🤠",
+	"vomiting_face":              "🤮",
+	"smiling_imp":                "😈",
+	"imp":                        "👿",
+	"japanese_ogre":              "👹",
+}
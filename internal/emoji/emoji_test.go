@@ -0,0 +1,25 @@
+package emoji
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookup(t *testing.T) {
+	for shortcode, want := range map[string]string{
+		"thumbsup":        "👍",
+		"thumbs_up":       "👍",
+		"thumbs_up_tone1": "👍🏻",
+		"flag_us":         "🇺🇸",
+		"hash":            "#️⃣",
+		"pinata":          "🪅",
+	} {
+		got, ok := Lookup(shortcode)
+		assert.True(t, ok, shortcode)
+		assert.Equal(t, want, got, shortcode)
+	}
+
+	_, ok := Lookup("not_an_emoji")
+	assert.False(t, ok)
+}
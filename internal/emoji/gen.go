@@ -0,0 +1,180 @@
+//go:build ignore
+// +build ignore
+
+// gen writes tables.go from Unicode's emoji-test.txt, naming each emoji
+// after its CLDR short name the way Discord does: "thumbs up: light skin
+// tone" becomes thumbs_up_tone1.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	data   = flag.String("data", "https://unicode.org/Public/emoji/15.1/emoji-test.txt", "URL or path of emoji-test.txt")
+	output = flag.String("output", "tables.go", "file to write")
+)
+
+// skinTones maps the skin tone modifiers to Discord's suffixes.
+var skinTones = map[string]string{
+	"light skin tone":        "tone1",
+	"medium-light skin tone": "tone2",
+	"medium skin tone":       "tone3",
+	"medium-dark skin tone":  "tone4",
+	"dark skin tone":         "tone5",
+}
+
+// keycaps names the keycap emoji, whose names are otherwise only symbols.
+var keycaps = map[string]string{
+	"#": "hash", "*": "asterisk", "0": "zero", "1": "one", "2": "two",
+	"3": "three", "4": "four", "5": "five", "6": "six", "7": "seven",
+	"8": "eight", "9": "nine", "10": "keycap_ten",
+}
+
+// latin spells letters outside ASCII that appear in emoji names.
+var latin = strings.NewReplacer("á", "a", "å", "a", "ã", "a", "ç", "c",
+	"é", "e", "í", "i", "ñ", "n", "ô", "o", "ü", "u", "’", "")
+
+func open(name string) (io.ReadCloser, error) {
+	if !strings.HasPrefix(name, "https://") {
+		return os.Open(name)
+	}
+	resp, err := http.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("%s: %s", name, resp.Status)
+	}
+	return resp.Body, nil
+}
+
+// normalize turns a part of a name into a shortcode: lowercase ASCII
+// letters and digits separated by underscores.
+func normalize(s string) string {
+	s = latin.Replace(strings.ToLower(s))
+	return strings.Join(strings.FieldsFunc(s, func(r rune) bool {
+		return !('a' <= r && r <= 'z' || '0' <= r && r <= '9')
+	}), "_")
+}
+
+// shortcode returns the shortcode for an emoji, or "" if it has none.
+func shortcode(runes []rune, name string) string {
+	// flags are named after their country code
+	if len(runes) == 2 && runes[0] >= 0x1F1E6 && runes[0] <= 0x1F1FF &&
+		runes[1] >= 0x1F1E6 && runes[1] <= 0x1F1FF {
+		return fmt.Sprintf("flag_%c%c", 'a'+runes[0]-0x1F1E6,
+			'a'+runes[1]-0x1F1E6)
+	}
+
+	parts := strings.SplitN(name, ": ", 2)
+	if parts[0] == "keycap" && len(parts) == 2 {
+		return keycaps[parts[1]]
+	}
+
+	code := normalize(parts[0])
+	if len(parts) == 2 {
+		for _, detail := range strings.Split(parts[1], ", ") {
+			if tone, ok := skinTones[detail]; ok {
+				code += "_" + tone
+			} else {
+				code += "_" + normalize(detail)
+			}
+		}
+	}
+	return code
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("gen: ")
+	flag.Parse()
+
+	r, err := open(*data)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer r.Close()
+
+	emoji := make(map[string]string)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		// 1F44D ; fully-qualified # 👍 E0.6 thumbs up
+		line := scanner.Text()
+		fields := strings.SplitN(line, ";", 2)
+		if len(fields) != 2 || strings.HasPrefix(line, "#") {
+			continue
+		}
+		comment := strings.SplitN(fields[1], "#", 2)
+		if len(comment) != 2 ||
+			strings.TrimSpace(comment[0]) != "fully-qualified" {
+			continue
+		}
+
+		var runes []rune
+		for _, point := range strings.Fields(fields[0]) {
+			n, err := strconv.ParseUint(point, 16, 32)
+			if err != nil {
+				log.Fatalf("invalid code point in %q", line)
+			}
+			runes = append(runes, rune(n))
+		}
+
+		// emoji, version, name
+		description := strings.SplitN(strings.TrimSpace(comment[1]), " ", 3)
+		if len(description) != 3 {
+			log.Fatalf("no name in %q", line)
+		}
+
+		code := shortcode(runes, description[2])
+		if code == "" {
+			continue
+		}
+		// the file is in CLDR order, so the more common emoji come first
+		if _, ok := emoji[code]; !ok {
+			emoji[code] = string(runes)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		log.Fatal(err)
+	}
+
+	codes := make([]string, 0, len(emoji))
+	for code := range emoji {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by gen.go from %s; DO NOT EDIT.\n\n",
+		path.Base(*data))
+	fmt.Fprintf(&b, "package emoji\n\n")
+	fmt.Fprintf(&b, "// names maps shortcodes made from Unicode names to emoji.\n")
+	fmt.Fprintf(&b, "var names = map[string]string{\n")
+	for _, code := range codes {
+		fmt.Fprintf(&b, "\t%q: %q,\n", code, emoji[code])
+	}
+	fmt.Fprintf(&b, "}\n")
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(*output, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
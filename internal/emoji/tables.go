@@ -0,0 +1,3780 @@
+// Code generated by gen.go from emoji-test.txt; DO NOT EDIT.
+
+package emoji
+
+// names maps shortcodes made from Unicode names to emoji.
+var names = map[string]string{
+	"1st_place_medal":                       "🥇",
+	"2nd_place_medal":                       "🥈",
+	"3rd_place_medal":                       "🥉",
+	"a_button_blood_type":                   "🅰️",
+	"ab_button_blood_type":                  "🆎",
+	"abacus":                                "🧮",
+	"accordion":                             "🪗",
+	"adhesive_bandage":                      "🩹",
+	"admission_tickets":                     "🎟️",
+	"aerial_tramway":                        "🚡",
+	"airplane":                              "✈️",
+	"airplane_arrival":                      "🛬",
+	"airplane_departure":                    "🛫",
+	"alarm_clock":                           "⏰",
+	"alembic":                               "⚗️",
+	"alien":                                 "👽",
+	"alien_monster":                         "👾",
+	"ambulance":                             "🚑",
+	"american_football":                     "🏈",
+	"amphora":                               "🏺",
+	"anatomical_heart":                      "🫀",
+	"anchor":                                "⚓",
+	"anger_symbol":                          "💢",
+	"angry_face":                            "😠",
+	"angry_face_with_horns":                 "👿",
+	"anguished_face":                        "😧",
+	"ant":                                   "🐜",
+	"antenna_bars":                          "📶",
+	"anxious_face_with_sweat":               "😰",
+	"aquarius":                              "♒",
+	"aries":                                 "♈",
+	"articulated_lorry":                     "🚛",
+	"artist":                                "🧑\u200d🎨",
+	"artist_palette":                        "🎨",
+	"artist_tone1":                          "🧑🏻\u200d🎨",
+	"artist_tone2":                          "🧑🏼\u200d🎨",
+	"artist_tone3":                          "🧑🏽\u200d🎨",
+	"artist_tone4":                          "🧑🏾\u200d🎨",
+	"artist_tone5":                          "🧑🏿\u200d🎨",
+	"asterisk":                              "*️⃣",
+	"astonished_face":                       "😲",
+	"astronaut":                             "🧑\u200d🚀",
+	"astronaut_tone1":                       "🧑🏻\u200d🚀",
+	"astronaut_tone2":                       "🧑🏼\u200d🚀",
+	"astronaut_tone3":                       "🧑🏽\u200d🚀",
+	"astronaut_tone4":                       "🧑🏾\u200d🚀",
+	"astronaut_tone5":                       "🧑🏿\u200d🚀",
+	"atm_sign":                              "🏧",
+	"atom_symbol":                           "⚛️",
+	"auto_rickshaw":                         "🛺",
+	"automobile":                            "🚗",
+	"avocado":                               "🥑",
+	"axe":                                   "🪓",
+	"b_button_blood_type":                   "🅱️",
+	"baby":                                  "👶",
+	"baby_angel":                            "👼",
+	"baby_angel_tone1":                      "👼🏻",
+	"baby_angel_tone2":                      "👼🏼",
+	"baby_angel_tone3":                      "👼🏽",
+	"baby_angel_tone4":                      "👼🏾",
+	"baby_angel_tone5":                      "👼🏿",
+	"baby_bottle":                           "🍼",
+	"baby_chick":                            "🐤",
+	"baby_symbol":                           "🚼",
+	"baby_tone1":                            "👶🏻",
+	"baby_tone2":                            "👶🏼",
+	"baby_tone3":                            "👶🏽",
+	"baby_tone4":                            "👶🏾",
+	"baby_tone5":                            "👶🏿",
+	"back_arrow":                            "🔙",
+	"backhand_index_pointing_down":          "👇",
+	"backhand_index_pointing_down_tone1":    "👇🏻",
+	"backhand_index_pointing_down_tone2":    "👇🏼",
+	"backhand_index_pointing_down_tone3":    "👇🏽",
+	"backhand_index_pointing_down_tone4":    "👇🏾",
+	"backhand_index_pointing_down_tone5":    "👇🏿",
+	"backhand_index_pointing_left":          "👈",
+	"backhand_index_pointing_left_tone1":    "👈🏻",
+	"backhand_index_pointing_left_tone2":    "👈🏼",
+	"backhand_index_pointing_left_tone3":    "👈🏽",
+	"backhand_index_pointing_left_tone4":    "👈🏾",
+	"backhand_index_pointing_left_tone5":    "👈🏿",
+	"backhand_index_pointing_right":         "👉",
+	"backhand_index_pointing_right_tone1":   "👉🏻",
+	"backhand_index_pointing_right_tone2":   "👉🏼",
+	"backhand_index_pointing_right_tone3":   "👉🏽",
+	"backhand_index_pointing_right_tone4":   "👉🏾",
+	"backhand_index_pointing_right_tone5":   "👉🏿",
+	"backhand_index_pointing_up":            "👆",
+	"backhand_index_pointing_up_tone1":      "👆🏻",
+	"backhand_index_pointing_up_tone2":      "👆🏼",
+	"backhand_index_pointing_up_tone3":      "👆🏽",
+	"backhand_index_pointing_up_tone4":      "👆🏾",
+	"backhand_index_pointing_up_tone5":      "👆🏿",
+	"backpack":                              "🎒",
+	"bacon":                                 "🥓",
+	"badger":                                "🦡",
+	"badminton":                             "🏸",
+	"bagel":                                 "🥯",
+	"baggage_claim":                         "🛄",
+	"baguette_bread":                        "🥖",
+	"balance_scale":                         "⚖️",
+	"ballet_shoes":                          "🩰",
+	"balloon":                               "🎈",
+	"ballot_box_with_ballot":                "🗳️",
+	"banana":                                "🍌",
+	"banjo":                                 "🪕",
+	"bank":                                  "🏦",
+	"bar_chart":                             "📊",
+	"barber_pole":                           "💈",
+	"baseball":                              "⚾",
+	"basket":                                "🧺",
+	"basketball":                            "🏀",
+	"bat":                                   "🦇",
+	"bathtub":                               "🛁",
+	"battery":                               "🔋",
+	"beach_with_umbrella":                   "🏖️",
+	"beaming_face_with_smiling_eyes":        "😁",
+	"beans":                                 "🫘",
+	"bear":                                  "🐻",
+	"beating_heart":                         "💓",
+	"beaver":                                "🦫",
+	"bed":                                   "🛏️",
+	"beer_mug":                              "🍺",
+	"beetle":                                "🪲",
+	"bell":                                  "🔔",
+	"bell_pepper":                           "🫑",
+	"bell_with_slash":                       "🔕",
+	"bellhop_bell":                          "🛎️",
+	"bento_box":                             "🍱",
+	"beverage_box":                          "🧃",
+	"bicycle":                               "🚲",
+	"bikini":                                "👙",
+	"billed_cap":                            "🧢",
+	"biohazard":                             "☣️",
+	"bird":                                  "🐦",
+	"birthday_cake":                         "🎂",
+	"bison":                                 "🦬",
+	"biting_lip":                            "🫦",
+	"black_bird":                            "🐦\u200d⬛",
+	"black_cat":                             "🐈\u200d⬛",
+	"black_circle":                          "⚫",
+	"black_flag":                            "🏴",
+	"black_heart":                           "🖤",
+	"black_large_square":                    "⬛",
+	"black_medium_small_square":             "◾",
+	"black_medium_square":                   "◼️",
+	"black_nib":                             "✒️",
+	"black_small_square":                    "▪️",
+	"black_square_button":                   "🔲",
+	"blossom":                               "🌼",
+	"blowfish":                              "🐡",
+	"blue_book":                             "📘",
+	"blue_circle":                           "🔵",
+	"blue_heart":                            "💙",
+	"blue_square":                           "🟦",
+	"blueberries":                           "🫐",
+	"boar":                                  "🐗",
+	"bomb":                                  "💣",
+	"bone":                                  "🦴",
+	"bookmark":                              "🔖",
+	"bookmark_tabs":                         "📑",
+	"books":                                 "📚",
+	"boomerang":                             "🪃",
+	"bottle_with_popping_cork":              "🍾",
+	"bouquet":                               "💐",
+	"bow_and_arrow":                         "🏹",
+	"bowl_with_spoon":                       "🥣",
+	"bowling":                               "🎳",
+	"boxing_glove":                          "🥊",
+	"boy":                                   "👦",
+	"boy_tone1":                             "👦🏻",
+	"boy_tone2":                             "👦🏼",
+	"boy_tone3":                             "👦🏽",
+	"boy_tone4":                             "👦🏾",
+	"boy_tone5":                             "👦🏿",
+	"brain":                                 "🧠",
+	"bread":                                 "🍞",
+	"breast_feeding":                        "🤱",
+	"breast_feeding_tone1":                  "🤱🏻",
+	"breast_feeding_tone2":                  "🤱🏼",
+	"breast_feeding_tone3":                  "🤱🏽",
+	"breast_feeding_tone4":                  "🤱🏾",
+	"breast_feeding_tone5":                  "🤱🏿",
+	"brick":                                 "🧱",
+	"bridge_at_night":                       "🌉",
+	"briefcase":                             "💼",
+	"briefs":                                "🩲",
+	"bright_button":                         "🔆",
+	"broccoli":                              "🥦",
+	"broken_chain":                          "⛓️\u200d💥",
+	"broken_heart":                          "💔",
+	"broom":                                 "🧹",
+	"brown_circle":                          "🟤",
+	"brown_heart":                           "🤎",
+	"brown_mushroom":                        "🍄\u200d🟫",
+	"brown_square":                          "🟫",
+	"bubble_tea":                            "🧋",
+	"bubbles":                               "🫧",
+	"bucket":                                "🪣",
+	"bug":                                   "🐛",
+	"building_construction":                 "🏗️",
+	"bullet_train":                          "🚅",
+	"bullseye":                              "🎯",
+	"burrito":                               "🌯",
+	"bus":                                   "🚌",
+	"bus_stop":                              "🚏",
+	"bust_in_silhouette":                    "👤",
+	"busts_in_silhouette":                   "👥",
+	"butter":                                "🧈",
+	"butterfly":                             "🦋",
+	"cactus":                                "🌵",
+	"calendar":                              "📅",
+	"call_me_hand":                          "🤙",
+	"call_me_hand_tone1":                    "🤙🏻",
+	"call_me_hand_tone2":                    "🤙🏼",
+	"call_me_hand_tone3":                    "🤙🏽",
+	"call_me_hand_tone4":                    "🤙🏾",
+	"call_me_hand_tone5":                    "🤙🏿",
+	"camel":                                 "🐪",
+	"camera":                                "📷",
+	"camera_with_flash":                     "📸",
+	"camping":                               "🏕️",
+	"cancer":                                "♋",
+	"candle":                                "🕯️",
+	"candy":                                 "🍬",
+	"canned_food":                           "🥫",
+	"canoe":                                 "🛶",
+	"capricorn":                             "♑",
+	"card_file_box":                         "🗃️",
+	"card_index":                            "📇",
+	"card_index_dividers":                   "🗂️",
+	"carousel_horse":                        "🎠",
+	"carp_streamer":                         "🎏",
+	"carpentry_saw":                         "🪚",
+	"carrot":                                "🥕",
+	"castle":                                "🏰",
+	"cat":                                   "🐈",
+	"cat_face":                              "🐱",
+	"cat_with_tears_of_joy":                 "😹",
+	"cat_with_wry_smile":                    "😼",
+	"chains":                                "⛓️",
+	"chair":                                 "🪑",
+	"chart_decreasing":                      "📉",
+	"chart_increasing":                      "📈",
+	"chart_increasing_with_yen":             "💹",
+	"check_box_with_check":                  "☑️",
+	"check_mark":                            "✔️",
+	"check_mark_button":                     "✅",
+	"cheese_wedge":                          "🧀",
+	"chequered_flag":                        "🏁",
+	"cherries":                              "🍒",
+	"cherry_blossom":                        "🌸",
+	"chess_pawn":                            "♟️",
+	"chestnut":                              "🌰",
+	"chicken":                               "🐔",
+	"child":                                 "🧒",
+	"child_tone1":                           "🧒🏻",
+	"child_tone2":                           "🧒🏼",
+	"child_tone3":                           "🧒🏽",
+	"child_tone4":                           "🧒🏾",
+	"child_tone5":                           "🧒🏿",
+	"children_crossing":                     "🚸",
+	"chipmunk":                              "🐿️",
+	"chocolate_bar":                         "🍫",
+	"chopsticks":                            "🥢",
+	"christmas_tree":                        "🎄",
+	"church":                                "⛪",
+	"cigarette":                             "🚬",
+	"cinema":                                "🎦",
+	"circled_m":                             "Ⓜ️",
+	"circus_tent":                           "🎪",
+	"cityscape":                             "🏙️",
+	"cityscape_at_dusk":                     "🌆",
+	"cl_button":                             "🆑",
+	"clamp":                                 "🗜️",
+	"clapper_board":                         "🎬",
+	"clapping_hands":                        "👏",
+	"clapping_hands_tone1":                  "👏🏻",
+	"clapping_hands_tone2":                  "👏🏼",
+	"clapping_hands_tone3":                  "👏🏽",
+	"clapping_hands_tone4":                  "👏🏾",
+	"clapping_hands_tone5":                  "👏🏿",
+	"classical_building":                    "🏛️",
+	"clinking_beer_mugs":                    "🍻",
+	"clinking_glasses":                      "🥂",
+	"clipboard":                             "📋",
+	"clockwise_vertical_arrows":             "🔃",
+	"closed_book":                           "📕",
+	"closed_mailbox_with_lowered_flag":      "📪",
+	"closed_mailbox_with_raised_flag":       "📫",
+	"closed_umbrella":                       "🌂",
+	"cloud":                                 "☁️",
+	"cloud_with_lightning":                  "🌩️",
+	"cloud_with_lightning_and_rain":         "⛈️",
+	"cloud_with_rain":                       "🌧️",
+	"cloud_with_snow":                       "🌨️",
+	"clown_face":                            "🤡",
+	"club_suit":                             "♣️",
+	"clutch_bag":                            "👝",
+	"coat":                                  "🧥",
+	"cockroach":                             "🪳",
+	"cocktail_glass":                        "🍸",
+	"coconut":                               "🥥",
+	"coffin":                                "⚰️",
+	"coin":                                  "🪙",
+	"cold_face":                             "🥶",
+	"collision":                             "💥",
+	"comet":                                 "☄️",
+	"compass":                               "🧭",
+	"computer_disk":                         "💽",
+	"computer_mouse":                        "🖱️",
+	"confetti_ball":                         "🎊",
+	"confounded_face":                       "😖",
+	"confused_face":                         "😕",
+	"construction":                          "🚧",
+	"construction_worker":                   "👷",
+	"construction_worker_tone1":             "👷🏻",
+	"construction_worker_tone2":             "👷🏼",
+	"construction_worker_tone3":             "👷🏽",
+	"construction_worker_tone4":             "👷🏾",
+	"construction_worker_tone5":             "👷🏿",
+	"control_knobs":                         "🎛️",
+	"convenience_store":                     "🏪",
+	"cook":                                  "🧑\u200d🍳",
+	"cook_tone1":                            "🧑🏻\u200d🍳",
+	"cook_tone2":                            "🧑🏼\u200d🍳",
+	"cook_tone3":                            "🧑🏽\u200d🍳",
+	"cook_tone4":                            "🧑🏾\u200d🍳",
+	"cook_tone5":                            "🧑🏿\u200d🍳",
+	"cooked_rice":                           "🍚",
+	"cookie":                                "🍪",
+	"cooking":                               "🍳",
+	"cool_button":                           "🆒",
+	"copyright":                             "©️",
+	"coral":                                 "🪸",
+	"couch_and_lamp":                        "🛋️",
+	"counterclockwise_arrows_button":        "🔄",
+	"couple_with_heart":                     "💑",
+	"couple_with_heart_man_man":             "👨\u200d❤️\u200d👨",
+	"couple_with_heart_man_man_tone1":       "👨🏻\u200d❤️\u200d👨🏻",
+	"couple_with_heart_man_man_tone1_tone2": "👨🏻\u200d❤️\u200d👨🏼",
+	"couple_with_heart_man_man_tone1_tone3": "👨🏻\u200d❤️\u200d👨🏽",
+	"couple_with_heart_man_man_tone1_tone4": "👨🏻\u200d❤️\u200d👨🏾",
+	"couple_with_heart_man_man_tone1_tone5": "👨🏻\u200d❤️\u200d👨🏿",
+	"couple_with_heart_man_man_tone2":       "👨🏼\u200d❤️\u200d👨🏼",
+	"couple_with_heart_man_man_tone2_tone1": "👨🏼\u200d❤️\u200d👨🏻",
+	"couple_with_heart_man_man_tone2_tone3": "👨🏼\u200d❤️\u200d👨🏽",
+	"couple_with_heart_man_man_tone2_tone4": "👨🏼\u200d❤️\u200d👨🏾",
+	"couple_with_heart_man_man_tone2_tone5": "👨🏼\u200d❤️\u200d👨🏿",
+	"couple_with_heart_man_man_tone3":       "👨🏽\u200d❤️\u200d👨🏽",
+	"couple_with_heart_man_man_tone3_tone1": "👨🏽\u200d❤️\u200d👨🏻",
+	"couple_with_heart_man_man_tone3_tone2": "👨🏽\u200d❤️\u200d👨🏼",
+	"couple_with_heart_man_man_tone3_tone4": "👨🏽\u200d❤️\u200d👨🏾",
+	"couple_with_heart_man_man_tone3_tone5": "👨🏽\u200d❤️\u200d👨🏿",
+	"couple_with_heart_man_man_tone4":       "👨🏾\u200d❤️\u200d👨🏾",
+	"couple_with_heart_man_man_tone4_tone1": "👨🏾\u200d❤️\u200d👨🏻",
+	"couple_with_heart_man_man_tone4_tone2": "👨🏾\u200d❤️\u200d👨🏼",
+	"couple_with_heart_man_man_tone4_tone3": "👨🏾\u200d❤️\u200d👨🏽",
+	"couple_with_heart_man_man_tone4_tone5": "👨🏾\u200d❤️\u200d👨🏿",
+	"couple_with_heart_man_man_tone5":       "👨🏿\u200d❤️\u200d👨🏿",
+	"couple_with_heart_man_man_tone5_tone1": "👨🏿\u200d❤️\u200d👨🏻",
+	"couple_with_heart_man_man_tone5_tone2": "👨🏿\u200d❤️\u200d👨🏼",
+	"couple_with_heart_man_man_tone5_tone3": "👨🏿\u200d❤️\u200d👨🏽",
+	"couple_with_heart_man_man_tone5_tone4": "👨🏿\u200d❤️\u200d👨🏾",
+	"couple_with_heart_person_person_tone1_tone2": "🧑🏻\u200d❤️\u200d🧑🏼",
+	"couple_with_heart_person_person_tone1_tone3": "🧑🏻\u200d❤️\u200d🧑🏽",
+	"couple_with_heart_person_person_tone1_tone4": "🧑🏻\u200d❤️\u200d🧑🏾",
+	"couple_with_heart_person_person_tone1_tone5": "🧑🏻\u200d❤️\u200d🧑🏿",
+	"couple_with_heart_person_person_tone2_tone1": "🧑🏼\u200d❤️\u200d🧑🏻",
+	"couple_with_heart_person_person_tone2_tone3": "🧑🏼\u200d❤️\u200d🧑🏽",
+	"couple_with_heart_person_person_tone2_tone4": "🧑🏼\u200d❤️\u200d🧑🏾",
+	"couple_with_heart_person_person_tone2_tone5": "🧑🏼\u200d❤️\u200d🧑🏿",
+	"couple_with_heart_person_person_tone3_tone1": "🧑🏽\u200d❤️\u200d🧑🏻",
+	"couple_with_heart_person_person_tone3_tone2": "🧑🏽\u200d❤️\u200d🧑🏼",
+	"couple_with_heart_person_person_tone3_tone4": "🧑🏽\u200d❤️\u200d🧑🏾",
+	"couple_with_heart_person_person_tone3_tone5": "🧑🏽\u200d❤️\u200d🧑🏿",
+	"couple_with_heart_person_person_tone4_tone1": "🧑🏾\u200d❤️\u200d🧑🏻",
+	"couple_with_heart_person_person_tone4_tone2": "🧑🏾\u200d❤️\u200d🧑🏼",
+	"couple_with_heart_person_person_tone4_tone3": "🧑🏾\u200d❤️\u200d🧑🏽",
+	"couple_with_heart_person_person_tone4_tone5": "🧑🏾\u200d❤️\u200d🧑🏿",
+	"couple_with_heart_person_person_tone5_tone1": "🧑🏿\u200d❤️\u200d🧑🏻",
+	"couple_with_heart_person_person_tone5_tone2": "🧑🏿\u200d❤️\u200d🧑🏼",
+	"couple_with_heart_person_person_tone5_tone3": "🧑🏿\u200d❤️\u200d🧑🏽",
+	"couple_with_heart_person_person_tone5_tone4": "🧑🏿\u200d❤️\u200d🧑🏾",
+	"couple_with_heart_tone1":                     "💑🏻",
+	"couple_with_heart_tone2":                     "💑🏼",
+	"couple_with_heart_tone3":                     "💑🏽",
+	"couple_with_heart_tone4":                     "💑🏾",
+	"couple_with_heart_tone5":                     "💑🏿",
+	"couple_with_heart_woman_man":                 "👩\u200d❤️\u200d👨",
+	"couple_with_heart_woman_man_tone1":           "👩🏻\u200d❤️\u200d👨🏻",
+	"couple_with_heart_woman_man_tone1_tone2":     "👩🏻\u200d❤️\u200d👨🏼",
+	"couple_with_heart_woman_man_tone1_tone3":     "👩🏻\u200d❤️\u200d👨🏽",
+	"couple_with_heart_woman_man_tone1_tone4":     "👩🏻\u200d❤️\u200d👨🏾",
+	"couple_with_heart_woman_man_tone1_tone5":     "👩🏻\u200d❤️\u200d👨🏿",
+	"couple_with_heart_woman_man_tone2":           "👩🏼\u200d❤️\u200d👨🏼",
+	"couple_with_heart_woman_man_tone2_tone1":     "👩🏼\u200d❤️\u200d👨🏻",
+	"couple_with_heart_woman_man_tone2_tone3":     "👩🏼\u200d❤️\u200d👨🏽",
+	"couple_with_heart_woman_man_tone2_tone4":     "👩🏼\u200d❤️\u200d👨🏾",
+	"couple_with_heart_woman_man_tone2_tone5":     "👩🏼\u200d❤️\u200d👨🏿",
+	"couple_with_heart_woman_man_tone3":           "👩🏽\u200d❤️\u200d👨🏽",
+	"couple_with_heart_woman_man_tone3_tone1":     "👩🏽\u200d❤️\u200d👨🏻",
+	"couple_with_heart_woman_man_tone3_tone2":     "👩🏽\u200d❤️\u200d👨🏼",
+	"couple_with_heart_woman_man_tone3_tone4":     "👩🏽\u200d❤️\u200d👨🏾",
+	"couple_with_heart_woman_man_tone3_tone5":     "👩🏽\u200d❤️\u200d👨🏿",
+	"couple_with_heart_woman_man_tone4":           "👩🏾\u200d❤️\u200d👨🏾",
+	"couple_with_heart_woman_man_tone4_tone1":     "👩🏾\u200d❤️\u200d👨🏻",
+	"couple_with_heart_woman_man_tone4_tone2":     "👩🏾\u200d❤️\u200d👨🏼",
+	"couple_with_heart_woman_man_tone4_tone3":     "👩🏾\u200d❤️\u200d👨🏽",
+	"couple_with_heart_woman_man_tone4_tone5":     "👩🏾\u200d❤️\u200d👨🏿",
+	"couple_with_heart_woman_man_tone5":           "👩🏿\u200d❤️\u200d👨🏿",
+	"couple_with_heart_woman_man_tone5_tone1":     "👩🏿\u200d❤️\u200d👨🏻",
+	"couple_with_heart_woman_man_tone5_tone2":     "👩🏿\u200d❤️\u200d👨🏼",
+	"couple_with_heart_woman_man_tone5_tone3":     "👩🏿\u200d❤️\u200d👨🏽",
+	"couple_with_heart_woman_man_tone5_tone4":     "👩🏿\u200d❤️\u200d👨🏾",
+	"couple_with_heart_woman_woman":               "👩\u200d❤️\u200d👩",
+	"couple_with_heart_woman_woman_tone1":         "👩🏻\u200d❤️\u200d👩🏻",
+	"couple_with_heart_woman_woman_tone1_tone2":   "👩🏻\u200d❤️\u200d👩🏼",
+	"couple_with_heart_woman_woman_tone1_tone3":   "👩🏻\u200d❤️\u200d👩🏽",
+	"couple_with_heart_woman_woman_tone1_tone4":   "👩🏻\u200d❤️\u200d👩🏾",
+	"couple_with_heart_woman_woman_tone1_tone5":   "👩🏻\u200d❤️\u200d👩🏿",
+	"couple_with_heart_woman_woman_tone2":         "👩🏼\u200d❤️\u200d👩🏼",
+	"couple_with_heart_woman_woman_tone2_tone1":   "👩🏼\u200d❤️\u200d👩🏻",
+	"couple_with_heart_woman_woman_tone2_tone3":   "👩🏼\u200d❤️\u200d👩🏽",
+	"couple_with_heart_woman_woman_tone2_tone4":   "👩🏼\u200d❤️\u200d👩🏾",
+	"couple_with_heart_woman_woman_tone2_tone5":   "👩🏼\u200d❤️\u200d👩🏿",
+	"couple_with_heart_woman_woman_tone3":         "👩🏽\u200d❤️\u200d👩🏽",
+	"couple_with_heart_woman_woman_tone3_tone1":   "👩🏽\u200d❤️\u200d👩🏻",
+	"couple_with_heart_woman_woman_tone3_tone2":   "👩🏽\u200d❤️\u200d👩🏼",
+	"couple_with_heart_woman_woman_tone3_tone4":   "👩🏽\u200d❤️\u200d👩🏾",
+	"couple_with_heart_woman_woman_tone3_tone5":   "👩🏽\u200d❤️\u200d👩🏿",
+	"couple_with_heart_woman_woman_tone4":         "👩🏾\u200d❤️\u200d👩🏾",
+	"couple_with_heart_woman_woman_tone4_tone1":   "👩🏾\u200d❤️\u200d👩🏻",
+	"couple_with_heart_woman_woman_tone4_tone2":   "👩🏾\u200d❤️\u200d👩🏼",
+	"couple_with_heart_woman_woman_tone4_tone3":   "👩🏾\u200d❤️\u200d👩🏽",
+	"couple_with_heart_woman_woman_tone4_tone5":   "👩🏾\u200d❤️\u200d👩🏿",
+	"couple_with_heart_woman_woman_tone5":         "👩🏿\u200d❤️\u200d👩🏿",
+	"couple_with_heart_woman_woman_tone5_tone1":   "👩🏿\u200d❤️\u200d👩🏻",
+	"couple_with_heart_woman_woman_tone5_tone2":   "👩🏿\u200d❤️\u200d👩🏼",
+	"couple_with_heart_woman_woman_tone5_tone3":   "👩🏿\u200d❤️\u200d👩🏽",
+	"couple_with_heart_woman_woman_tone5_tone4":   "👩🏿\u200d❤️\u200d👩🏾",
+	"cow":                        "🐄",
+	"cow_face":                   "🐮",
+	"cowboy_hat_face":            "🤠",
+	"crab":                       "🦀",
+	"crayon":                     "🖍️",
+	"credit_card":                "💳",
+	"crescent_moon":              "🌙",
+	"cricket":                    "🦗",
+	"cricket_game":               "🏏",
+	"crocodile":                  "🐊",
+	"croissant":                  "🥐",
+	"cross_mark":                 "❌",
+	"cross_mark_button":          "❎",
+	"crossed_fingers":            "🤞",
+	"crossed_fingers_tone1":      "🤞🏻",
+	"crossed_fingers_tone2":      "🤞🏼",
+	"crossed_fingers_tone3":      "🤞🏽",
+	"crossed_fingers_tone4":      "🤞🏾",
+	"crossed_fingers_tone5":      "🤞🏿",
+	"crossed_flags":              "🎌",
+	"crossed_swords":             "⚔️",
+	"crown":                      "👑",
+	"crutch":                     "🩼",
+	"crying_cat":                 "😿",
+	"crying_face":                "😢",
+	"crystal_ball":               "🔮",
+	"cucumber":                   "🥒",
+	"cup_with_straw":             "🥤",
+	"cupcake":                    "🧁",
+	"curling_stone":              "🥌",
+	"curly_loop":                 "➰",
+	"currency_exchange":          "💱",
+	"curry_rice":                 "🍛",
+	"custard":                    "🍮",
+	"customs":                    "🛃",
+	"cut_of_meat":                "🥩",
+	"cyclone":                    "🌀",
+	"dagger":                     "🗡️",
+	"dango":                      "🍡",
+	"dashing_away":               "💨",
+	"deaf_man":                   "🧏\u200d♂️",
+	"deaf_man_tone1":             "🧏🏻\u200d♂️",
+	"deaf_man_tone2":             "🧏🏼\u200d♂️",
+	"deaf_man_tone3":             "🧏🏽\u200d♂️",
+	"deaf_man_tone4":             "🧏🏾\u200d♂️",
+	"deaf_man_tone5":             "🧏🏿\u200d♂️",
+	"deaf_person":                "🧏",
+	"deaf_person_tone1":          "🧏🏻",
+	"deaf_person_tone2":          "🧏🏼",
+	"deaf_person_tone3":          "🧏🏽",
+	"deaf_person_tone4":          "🧏🏾",
+	"deaf_person_tone5":          "🧏🏿",
+	"deaf_woman":                 "🧏\u200d♀️",
+	"deaf_woman_tone1":           "🧏🏻\u200d♀️",
+	"deaf_woman_tone2":           "🧏🏼\u200d♀️",
+	"deaf_woman_tone3":           "🧏🏽\u200d♀️",
+	"deaf_woman_tone4":           "🧏🏾\u200d♀️",
+	"deaf_woman_tone5":           "🧏🏿\u200d♀️",
+	"deciduous_tree":             "🌳",
+	"deer":                       "🦌",
+	"delivery_truck":             "🚚",
+	"department_store":           "🏬",
+	"derelict_house":             "🏚️",
+	"desert":                     "🏜️",
+	"desert_island":              "🏝️",
+	"desktop_computer":           "🖥️",
+	"detective":                  "🕵️",
+	"detective_tone1":            "🕵🏻",
+	"detective_tone2":            "🕵🏼",
+	"detective_tone3":            "🕵🏽",
+	"detective_tone4":            "🕵🏾",
+	"detective_tone5":            "🕵🏿",
+	"diamond_suit":               "♦️",
+	"diamond_with_a_dot":         "💠",
+	"dim_button":                 "🔅",
+	"disappointed_face":          "😞",
+	"disguised_face":             "🥸",
+	"divide":                     "➗",
+	"diving_mask":                "🤿",
+	"diya_lamp":                  "🪔",
+	"dizzy":                      "💫",
+	"dna":                        "🧬",
+	"dodo":                       "🦤",
+	"dog":                        "🐕",
+	"dog_face":                   "🐶",
+	"dollar_banknote":            "💵",
+	"dolphin":                    "🐬",
+	"donkey":                     "🫏",
+	"door":                       "🚪",
+	"dotted_line_face":           "🫥",
+	"dotted_six_pointed_star":    "🔯",
+	"double_curly_loop":          "➿",
+	"double_exclamation_mark":    "‼️",
+	"doughnut":                   "🍩",
+	"dove":                       "🕊️",
+	"down_arrow":                 "⬇️",
+	"down_left_arrow":            "↙️",
+	"down_right_arrow":           "↘️",
+	"downcast_face_with_sweat":   "😓",
+	"downwards_button":           "🔽",
+	"dragon":                     "🐉",
+	"dragon_face":                "🐲",
+	"dress":                      "👗",
+	"drooling_face":              "🤤",
+	"drop_of_blood":              "🩸",
+	"droplet":                    "💧",
+	"drum":                       "🥁",
+	"duck":                       "🦆",
+	"dumpling":                   "🥟",
+	"dvd":                        "📀",
+	"e_mail":                     "📧",
+	"eagle":                      "🦅",
+	"ear":                        "👂",
+	"ear_of_corn":                "🌽",
+	"ear_tone1":                  "👂🏻",
+	"ear_tone2":                  "👂🏼",
+	"ear_tone3":                  "👂🏽",
+	"ear_tone4":                  "👂🏾",
+	"ear_tone5":                  "👂🏿",
+	"ear_with_hearing_aid":       "🦻",
+	"ear_with_hearing_aid_tone1": "🦻🏻",
+	"ear_with_hearing_aid_tone2": "🦻🏼",
+	"ear_with_hearing_aid_tone3": "🦻🏽",
+	"ear_with_hearing_aid_tone4": "🦻🏾",
+	"ear_with_hearing_aid_tone5": "🦻🏿",
+	"egg":                        "🥚",
+	"eggplant":                   "🍆",
+	"eight":                      "8️⃣",
+	"eight_oclock":               "🕗",
+	"eight_pointed_star":         "✴️",
+	"eight_spoked_asterisk":      "✳️",
+	"eight_thirty":               "🕣",
+	"eject_button":               "⏏️",
+	"electric_plug":              "🔌",
+	"elephant":                   "🐘",
+	"elevator":                   "🛗",
+	"eleven_oclock":              "🕚",
+	"eleven_thirty":              "🕦",
+	"elf":                        "🧝",
+	"elf_tone1":                  "🧝🏻",
+	"elf_tone2":                  "🧝🏼",
+	"elf_tone3":                  "🧝🏽",
+	"elf_tone4":                  "🧝🏾",
+	"elf_tone5":                  "🧝🏿",
+	"empty_nest":                 "🪹",
+	"end_arrow":                  "🔚",
+	"enraged_face":               "😡",
+	"envelope":                   "✉️",
+	"envelope_with_arrow":        "📩",
+	"euro_banknote":              "💶",
+	"evergreen_tree":             "🌲",
+	"ewe":                        "🐑",
+	"exclamation_question_mark":  "⁉️",
+	"exploding_head":             "🤯",
+	"expressionless_face":        "😑",
+	"eye":                        "👁️",
+	"eye_in_speech_bubble":       "👁️\u200d🗨️",
+	"eyes":                       "👀",
+	"face_blowing_a_kiss":        "😘",
+	"face_exhaling":              "😮\u200d💨",
+	"face_holding_back_tears":    "🥹",
+	"face_in_clouds":             "😶\u200d🌫️",
+	"face_savoring_food":         "😋",
+	"face_screaming_in_fear":     "😱",
+	"face_vomiting":              "🤮",
+	"face_with_crossed_out_eyes": "😵",
+	"face_with_diagonal_mouth":   "🫤",
+	"face_with_hand_over_mouth":  "🤭",
+	"face_with_head_bandage":     "🤕",
+	"face_with_medical_mask":     "😷",
+	"face_with_monocle":          "🧐",
+	"face_with_open_eyes_and_hand_over_mouth": "🫢",
+	"face_with_open_mouth":                    "😮",
+	"face_with_peeking_eye":                   "🫣",
+	"face_with_raised_eyebrow":                "🤨",
+	"face_with_rolling_eyes":                  "🙄",
+	"face_with_spiral_eyes":                   "😵\u200d💫",
+	"face_with_steam_from_nose":               "😤",
+	"face_with_symbols_on_mouth":              "🤬",
+	"face_with_tears_of_joy":                  "😂",
+	"face_with_thermometer":                   "🤒",
+	"face_with_tongue":                        "😛",
+	"face_without_mouth":                      "😶",
+	"factory":                                 "🏭",
+	"factory_worker":                          "🧑\u200d🏭",
+	"factory_worker_tone1":                    "🧑🏻\u200d🏭",
+	"factory_worker_tone2":                    "🧑🏼\u200d🏭",
+	"factory_worker_tone3":                    "🧑🏽\u200d🏭",
+	"factory_worker_tone4":                    "🧑🏾\u200d🏭",
+	"factory_worker_tone5":                    "🧑🏿\u200d🏭",
+	"fairy":                                   "🧚",
+	"fairy_tone1":                             "🧚🏻",
+	"fairy_tone2":                             "🧚🏼",
+	"fairy_tone3":                             "🧚🏽",
+	"fairy_tone4":                             "🧚🏾",
+	"fairy_tone5":                             "🧚🏿",
+	"falafel":                                 "🧆",
+	"fallen_leaf":                             "🍂",
+	"family":                                  "👪",
+	"family_adult_adult_child":                "🧑\u200d🧑\u200d🧒",
+	"family_adult_adult_child_child":          "🧑\u200d🧑\u200d🧒\u200d🧒",
+	"family_adult_child":                      "🧑\u200d🧒",
+	"family_adult_child_child":                "🧑\u200d🧒\u200d🧒",
+	"family_man_boy":                          "👨\u200d👦",
+	"family_man_boy_boy":                      "👨\u200d👦\u200d👦",
+	"family_man_girl":                         "👨\u200d👧",
+	"family_man_girl_boy":                     "👨\u200d👧\u200d👦",
+	"family_man_girl_girl":                    "👨\u200d👧\u200d👧",
+	"family_man_man_boy":                      "👨\u200d👨\u200d👦",
+	"family_man_man_boy_boy":                  "👨\u200d👨\u200d👦\u200d👦",
+	"family_man_man_girl":                     "👨\u200d👨\u200d👧",
+	"family_man_man_girl_boy":                 "👨\u200d👨\u200d👧\u200d👦",
+	"family_man_man_girl_girl":                "👨\u200d👨\u200d👧\u200d👧",
+	"family_man_woman_boy":                    "👨\u200d👩\u200d👦",
+	"family_man_woman_boy_boy":                "👨\u200d👩\u200d👦\u200d👦",
+	"family_man_woman_girl":                   "👨\u200d👩\u200d👧",
+	"family_man_woman_girl_boy":               "👨\u200d👩\u200d👧\u200d👦",
+	"family_man_woman_girl_girl":              "👨\u200d👩\u200d👧\u200d👧",
+	"family_woman_boy":                        "👩\u200d👦",
+	"family_woman_boy_boy":                    "👩\u200d👦\u200d👦",
+	"family_woman_girl":                       "👩\u200d👧",
+	"family_woman_girl_boy":                   "👩\u200d👧\u200d👦",
+	"family_woman_girl_girl":                  "👩\u200d👧\u200d👧",
+	"family_woman_woman_boy":                  "👩\u200d👩\u200d👦",
+	"family_woman_woman_boy_boy":              "👩\u200d👩\u200d👦\u200d👦",
+	"family_woman_woman_girl":                 "👩\u200d👩\u200d👧",
+	"family_woman_woman_girl_boy":             "👩\u200d👩\u200d👧\u200d👦",
+	"family_woman_woman_girl_girl":            "👩\u200d👩\u200d👧\u200d👧",
+	"farmer":                                  "🧑\u200d🌾",
+	"farmer_tone1":                            "🧑🏻\u200d🌾",
+	"farmer_tone2":                            "🧑🏼\u200d🌾",
+	"farmer_tone3":                            "🧑🏽\u200d🌾",
+	"farmer_tone4":                            "🧑🏾\u200d🌾",
+	"farmer_tone5":                            "🧑🏿\u200d🌾",
+	"fast_down_button":                        "⏬",
+	"fast_forward_button":                     "⏩",
+	"fast_reverse_button":                     "⏪",
+	"fast_up_button":                          "⏫",
+	"fax_machine":                             "📠",
+	"fearful_face":                            "😨",
+	"feather":                                 "🪶",
+	"female_sign":                             "♀️",
+	"ferris_wheel":                            "🎡",
+	"ferry":                                   "⛴️",
+	"field_hockey":                            "🏑",
+	"file_cabinet":                            "🗄️",
+	"file_folder":                             "📁",
+	"film_frames":                             "🎞️",
+	"film_projector":                          "📽️",
+	"fire":                                    "🔥",
+	"fire_engine":                             "🚒",
+	"fire_extinguisher":                       "🧯",
+	"firecracker":                             "🧨",
+	"firefighter":                             "🧑\u200d🚒",
+	"firefighter_tone1":                       "🧑🏻\u200d🚒",
+	"firefighter_tone2":                       "🧑🏼\u200d🚒",
+	"firefighter_tone3":                       "🧑🏽\u200d🚒",
+	"firefighter_tone4":                       "🧑🏾\u200d🚒",
+	"firefighter_tone5":                       "🧑🏿\u200d🚒",
+	"fireworks":                               "🎆",
+	"first_quarter_moon":                      "🌓",
+	"first_quarter_moon_face":                 "🌛",
+	"fish":                                    "🐟",
+	"fish_cake_with_swirl":                    "🍥",
+	"fishing_pole":                            "🎣",
+	"five":                                    "5️⃣",
+	"five_oclock":                             "🕔",
+	"five_thirty":                             "🕠",
+	"flag_ac":                                 "🇦🇨",
+	"flag_ad":                                 "🇦🇩",
+	"flag_ae":                                 "🇦🇪",
+	"flag_af":                                 "🇦🇫",
+	"flag_ag":                                 "🇦🇬",
+	"flag_ai":                                 "🇦🇮",
+	"flag_al":                                 "🇦🇱",
+	"flag_am":                                 "🇦🇲",
+	"flag_ao":                                 "🇦🇴",
+	"flag_aq":                                 "🇦🇶",
+	"flag_ar":                                 "🇦🇷",
+	"flag_as":                                 "🇦🇸",
+	"flag_at":                                 "🇦🇹",
+	"flag_au":                                 "🇦🇺",
+	"flag_aw":                                 "🇦🇼",
+	"flag_ax":                                 "🇦🇽",
+	"flag_az":                                 "🇦🇿",
+	"flag_ba":                                 "🇧🇦",
+	"flag_bb":                                 "🇧🇧",
+	"flag_bd":                                 "🇧🇩",
+	"flag_be":                                 "🇧🇪",
+	"flag_bf":                                 "🇧🇫",
+	"flag_bg":                                 "🇧🇬",
+	"flag_bh":                                 "🇧🇭",
+	"flag_bi":                                 "🇧🇮",
+	"flag_bj":                                 "🇧🇯",
+	"flag_bl":                                 "🇧🇱",
+	"flag_bm":                                 "🇧🇲",
+	"flag_bn":                                 "🇧🇳",
+	"flag_bo":                                 "🇧🇴",
+	"flag_bq":                                 "🇧🇶",
+	"flag_br":                                 "🇧🇷",
+	"flag_bs":                                 "🇧🇸",
+	"flag_bt":                                 "🇧🇹",
+	"flag_bv":                                 "🇧🇻",
+	"flag_bw":                                 "🇧🇼",
+	"flag_by":                                 "🇧🇾",
+	"flag_bz":                                 "🇧🇿",
+	"flag_ca":                                 "🇨🇦",
+	"flag_cc":                                 "🇨🇨",
+	"flag_cd":                                 "🇨🇩",
+	"flag_cf":                                 "🇨🇫",
+	"flag_cg":                                 "🇨🇬",
+	"flag_ch":                                 "🇨🇭",
+	"flag_ci":                                 "🇨🇮",
+	"flag_ck":                                 "🇨🇰",
+	"flag_cl":                                 "🇨🇱",
+	"flag_cm":                                 "🇨🇲",
+	"flag_cn":                                 "🇨🇳",
+	"flag_co":                                 "🇨🇴",
+	"flag_cp":                                 "🇨🇵",
+	"flag_cr":                                 "🇨🇷",
+	"flag_cu":                                 "🇨🇺",
+	"flag_cv":                                 "🇨🇻",
+	"flag_cw":                                 "🇨🇼",
+	"flag_cx":                                 "🇨🇽",
+	"flag_cy":                                 "🇨🇾",
+	"flag_cz":                                 "🇨🇿",
+	"flag_de":                                 "🇩🇪",
+	"flag_dg":                                 "🇩🇬",
+	"flag_dj":                                 "🇩🇯",
+	"flag_dk":                                 "🇩🇰",
+	"flag_dm":                                 "🇩🇲",
+	"flag_do":                                 "🇩🇴",
+	"flag_dz":                                 "🇩🇿",
+	"flag_ea":                                 "🇪🇦",
+	"flag_ec":                                 "🇪🇨",
+	"flag_ee":                                 "🇪🇪",
+	"flag_eg":                                 "🇪🇬",
+	"flag_eh":                                 "🇪🇭",
+	"flag_england":                            "🏴\U000e0067\U000e0062\U000e0065\U000e006e\U000e0067\U000e007f",
+	"flag_er":                                 "🇪🇷",
+	"flag_es":                                 "🇪🇸",
+	"flag_et":                                 "🇪🇹",
+	"flag_eu":                                 "🇪🇺",
+	"flag_fi":                                 "🇫🇮",
+	"flag_fj":                                 "🇫🇯",
+	"flag_fk":                                 "🇫🇰",
+	"flag_fm":                                 "🇫🇲",
+	"flag_fo":                                 "🇫🇴",
+	"flag_fr":                                 "🇫🇷",
+	"flag_ga":                                 "🇬🇦",
+	"flag_gb":                                 "🇬🇧",
+	"flag_gd":                                 "🇬🇩",
+	"flag_ge":                                 "🇬🇪",
+	"flag_gf":                                 "🇬🇫",
+	"flag_gg":                                 "🇬🇬",
+	"flag_gh":                                 "🇬🇭",
+	"flag_gi":                                 "🇬🇮",
+	"flag_gl":                                 "🇬🇱",
+	"flag_gm":                                 "🇬🇲",
+	"flag_gn":                                 "🇬🇳",
+	"flag_gp":                                 "🇬🇵",
+	"flag_gq":                                 "🇬🇶",
+	"flag_gr":                                 "🇬🇷",
+	"flag_gs":                                 "🇬🇸",
+	"flag_gt":                                 "🇬🇹",
+	"flag_gu":                                 "🇬🇺",
+	"flag_gw":                                 "🇬🇼",
+	"flag_gy":                                 "🇬🇾",
+	"flag_hk":                                 "🇭🇰",
+	"flag_hm":                                 "🇭🇲",
+	"flag_hn":                                 "🇭🇳",
+	"flag_hr":                                 "🇭🇷",
+	"flag_ht":                                 "🇭🇹",
+	"flag_hu":                                 "🇭🇺",
+	"flag_ic":                                 "🇮🇨",
+	"flag_id":                                 "🇮🇩",
+	"flag_ie":                                 "🇮🇪",
+	"flag_il":                                 "🇮🇱",
+	"flag_im":                                 "🇮🇲",
+	"flag_in":                                 "🇮🇳",
+	"flag_in_hole":                            "⛳",
+	"flag_io":                                 "🇮🇴",
+	"flag_iq":                                 "🇮🇶",
+	"flag_ir":                                 "🇮🇷",
+	"flag_is":                                 "🇮🇸",
+	"flag_it":                                 "🇮🇹",
+	"flag_je":                                 "🇯🇪",
+	"flag_jm":                                 "🇯🇲",
+	"flag_jo":                                 "🇯🇴",
+	"flag_jp":                                 "🇯🇵",
+	"flag_ke":                                 "🇰🇪",
+	"flag_kg":                                 "🇰🇬",
+	"flag_kh":                                 "🇰🇭",
+	"flag_ki":                                 "🇰🇮",
+	"flag_km":                                 "🇰🇲",
+	"flag_kn":                                 "🇰🇳",
+	"flag_kp":                                 "🇰🇵",
+	"flag_kr":                                 "🇰🇷",
+	"flag_kw":                                 "🇰🇼",
+	"flag_ky":                                 "🇰🇾",
+	"flag_kz":                                 "🇰🇿",
+	"flag_la":                                 "🇱🇦",
+	"flag_lb":                                 "🇱🇧",
+	"flag_lc":                                 "🇱🇨",
+	"flag_li":                                 "🇱🇮",
+	"flag_lk":                                 "🇱🇰",
+	"flag_lr":                                 "🇱🇷",
+	"flag_ls":                                 "🇱🇸",
+	"flag_lt":                                 "🇱🇹",
+	"flag_lu":                                 "🇱🇺",
+	"flag_lv":                                 "🇱🇻",
+	"flag_ly":                                 "🇱🇾",
+	"flag_ma":                                 "🇲🇦",
+	"flag_mc":                                 "🇲🇨",
+	"flag_md":                                 "🇲🇩",
+	"flag_me":                                 "🇲🇪",
+	"flag_mf":                                 "🇲🇫",
+	"flag_mg":                                 "🇲🇬",
+	"flag_mh":                                 "🇲🇭",
+	"flag_mk":                                 "🇲🇰",
+	"flag_ml":                                 "🇲🇱",
+	"flag_mm":                                 "🇲🇲",
+	"flag_mn":                                 "🇲🇳",
+	"flag_mo":                                 "🇲🇴",
+	"flag_mp":                                 "🇲🇵",
+	"flag_mq":                                 "🇲🇶",
+	"flag_mr":                                 "🇲🇷",
+	"flag_ms":                                 "🇲🇸",
+	"flag_mt":                                 "🇲🇹",
+	"flag_mu":                                 "🇲🇺",
+	"flag_mv":                                 "🇲🇻",
+	"flag_mw":                                 "🇲🇼",
+	"flag_mx":                                 "🇲🇽",
+	"flag_my":                                 "🇲🇾",
+	"flag_mz":                                 "🇲🇿",
+	"flag_na":                                 "🇳🇦",
+	"flag_nc":                                 "🇳🇨",
+	"flag_ne":                                 "🇳🇪",
+	"flag_nf":                                 "🇳🇫",
+	"flag_ng":                                 "🇳🇬",
+	"flag_ni":                                 "🇳🇮",
+	"flag_nl":                                 "🇳🇱",
+	"flag_no":                                 "🇳🇴",
+	"flag_np":                                 "🇳🇵",
+	"flag_nr":                                 "🇳🇷",
+	"flag_nu":                                 "🇳🇺",
+	"flag_nz":                                 "🇳🇿",
+	"flag_om":                                 "🇴🇲",
+	"flag_pa":                                 "🇵🇦",
+	"flag_pe":                                 "🇵🇪",
+	"flag_pf":                                 "🇵🇫",
+	"flag_pg":                                 "🇵🇬",
+	"flag_ph":                                 "🇵🇭",
+	"flag_pk":                                 "🇵🇰",
+	"flag_pl":                                 "🇵🇱",
+	"flag_pm":                                 "🇵🇲",
+	"flag_pn":                                 "🇵🇳",
+	"flag_pr":                                 "🇵🇷",
+	"flag_ps":                                 "🇵🇸",
+	"flag_pt":                                 "🇵🇹",
+	"flag_pw":                                 "🇵🇼",
+	"flag_py":                                 "🇵🇾",
+	"flag_qa":                                 "🇶🇦",
+	"flag_re":                                 "🇷🇪",
+	"flag_ro":                                 "🇷🇴",
+	"flag_rs":                                 "🇷🇸",
+	"flag_ru":                                 "🇷🇺",
+	"flag_rw":                                 "🇷🇼",
+	"flag_sa":                                 "🇸🇦",
+	"flag_sb":                                 "🇸🇧",
+	"flag_sc":                                 "🇸🇨",
+	"flag_scotland":                           "🏴\U000e0067\U000e0062\U000e0073\U000e0063\U000e0074\U000e007f",
+	"flag_sd":                                 "🇸🇩",
+	"flag_se":                                 "🇸🇪",
+	"flag_sg":                                 "🇸🇬",
+	"flag_sh":                                 "🇸🇭",
+	"flag_si":                                 "🇸🇮",
+	"flag_sj":                                 "🇸🇯",
+	"flag_sk":                                 "🇸🇰",
+	"flag_sl":                                 "🇸🇱",
+	"flag_sm":                                 "🇸🇲",
+	"flag_sn":                                 "🇸🇳",
+	"flag_so":                                 "🇸🇴",
+	"flag_sr":                                 "🇸🇷",
+	"flag_ss":                                 "🇸🇸",
+	"flag_st":                                 "🇸🇹",
+	"flag_sv":                                 "🇸🇻",
+	"flag_sx":                                 "🇸🇽",
+	"flag_sy":                                 "🇸🇾",
+	"flag_sz":                                 "🇸🇿",
+	"flag_ta":                                 "🇹🇦",
+	"flag_tc":                                 "🇹🇨",
+	"flag_td":                                 "🇹🇩",
+	"flag_tf":                                 "🇹🇫",
+	"flag_tg":                                 "🇹🇬",
+	"flag_th":                                 "🇹🇭",
+	"flag_tj":                                 "🇹🇯",
+	"flag_tk":                                 "🇹🇰",
+	"flag_tl":                                 "🇹🇱",
+	"flag_tm":                                 "🇹🇲",
+	"flag_tn":                                 "🇹🇳",
+	"flag_to":                                 "🇹🇴",
+	"flag_tr":                                 "🇹🇷",
+	"flag_tt":                                 "🇹🇹",
+	"flag_tv":                                 "🇹🇻",
+	"flag_tw":                                 "🇹🇼",
+	"flag_tz":                                 "🇹🇿",
+	"flag_ua":                                 "🇺🇦",
+	"flag_ug":                                 "🇺🇬",
+	"flag_um":                                 "🇺🇲",
+	"flag_un":                                 "🇺🇳",
+	"flag_us":                                 "🇺🇸",
+	"flag_uy":                                 "🇺🇾",
+	"flag_uz":                                 "🇺🇿",
+	"flag_va":                                 "🇻🇦",
+	"flag_vc":                                 "🇻🇨",
+	"flag_ve":                                 "🇻🇪",
+	"flag_vg":                                 "🇻🇬",
+	"flag_vi":                                 "🇻🇮",
+	"flag_vn":                                 "🇻🇳",
+	"flag_vu":                                 "🇻🇺",
+	"flag_wales":                              "🏴\U000e0067\U000e0062\U000e0077\U000e006c\U000e0073\U000e007f",
+	"flag_wf":                                 "🇼🇫",
+	"flag_ws":                                 "🇼🇸",
+	"flag_xk":                                 "🇽🇰",
+	"flag_ye":                                 "🇾🇪",
+	"flag_yt":                                 "🇾🇹",
+	"flag_za":                                 "🇿🇦",
+	"flag_zm":                                 "🇿🇲",
+	"flag_zw":                                 "🇿🇼",
+	"flamingo":                                "🦩",
+	"flashlight":                              "🔦",
+	"flat_shoe":                               "🥿",
+	"flatbread":                               "🫓",
+	"fleur_de_lis":                            "⚜️",
+	"flexed_biceps":                           "💪",
+	"flexed_biceps_tone1":                     "💪🏻",
+	"flexed_biceps_tone2":                     "💪🏼",
+	"flexed_biceps_tone3":                     "💪🏽",
+	"flexed_biceps_tone4":                     "💪🏾",
+	"flexed_biceps_tone5":                     "💪🏿",
+	"floppy_disk":                             "💾",
+	"flower_playing_cards":                    "🎴",
+	"flushed_face":                            "😳",
+	"flute":                                   "🪈",
+	"fly":                                     "🪰",
+	"flying_disc":                             "🥏",
+	"flying_saucer":                           "🛸",
+	"fog":                                     "🌫️",
+	"foggy":                                   "🌁",
+	"folded_hands":                            "🙏",
+	"folded_hands_tone1":                      "🙏🏻",
+	"folded_hands_tone2":                      "🙏🏼",
+	"folded_hands_tone3":                      "🙏🏽",
+	"folded_hands_tone4":                      "🙏🏾",
+	"folded_hands_tone5":                      "🙏🏿",
+	"folding_hand_fan":                        "🪭",
+	"fondue":                                  "🫕",
+	"foot":                                    "🦶",
+	"foot_tone1":                              "🦶🏻",
+	"foot_tone2":                              "🦶🏼",
+	"foot_tone3":                              "🦶🏽",
+	"foot_tone4":                              "🦶🏾",
+	"foot_tone5":                              "🦶🏿",
+	"footprints":                              "👣",
+	"fork_and_knife":                          "🍴",
+	"fork_and_knife_with_plate":               "🍽️",
+	"fortune_cookie":                          "🥠",
+	"fountain":                                "⛲",
+	"fountain_pen":                            "🖋️",
+	"four":                                    "4️⃣",
+	"four_leaf_clover":                        "🍀",
+	"four_oclock":                             "🕓",
+	"four_thirty":                             "🕟",
+	"fox":                                     "🦊",
+	"framed_picture":                          "🖼️",
+	"free_button":                             "🆓",
+	"french_fries":                            "🍟",
+	"fried_shrimp":                            "🍤",
+	"frog":                                    "🐸",
+	"front_facing_baby_chick":                 "🐥",
+	"frowning_face":                           "☹️",
+	"frowning_face_with_open_mouth":           "😦",
+	"fuel_pump":                               "⛽",
+	"full_moon":                               "🌕",
+	"full_moon_face":                          "🌝",
+	"funeral_urn":                             "⚱️",
+	"game_die":                                "🎲",
+	"garlic":                                  "🧄",
+	"gear":                                    "⚙️",
+	"gem_stone":                               "💎",
+	"gemini":                                  "♊",
+	"genie":                                   "🧞",
+	"ghost":                                   "👻",
+	"ginger_root":                             "🫚",
+	"giraffe":                                 "🦒",
+	"girl":                                    "👧",
+	"girl_tone1":                              "👧🏻",
+	"girl_tone2":                              "👧🏼",
+	"girl_tone3":                              "👧🏽",
+	"girl_tone4":                              "👧🏾",
+	"girl_tone5":                              "👧🏿",
+	"glass_of_milk":                           "🥛",
+	"glasses":                                 "👓",
+	"globe_showing_americas":                  "🌎",
+	"globe_showing_asia_australia":            "🌏",
+	"globe_showing_europe_africa":             "🌍",
+	"globe_with_meridians":                    "🌐",
+	"gloves":                                  "🧤",
+	"glowing_star":                            "🌟",
+	"goal_net":                                "🥅",
+	"goat":                                    "🐐",
+	"goblin":                                  "👺",
+	"goggles":                                 "🥽",
+	"goose":                                   "🪿",
+	"gorilla":                                 "🦍",
+	"graduation_cap":                          "🎓",
+	"grapes":                                  "🍇",
+	"green_apple":                             "🍏",
+	"green_book":                              "📗",
+	"green_circle":                            "🟢",
+	"green_heart":                             "💚",
+	"green_salad":                             "🥗",
+	"green_square":                            "🟩",
+	"grey_heart":                              "🩶",
+	"grimacing_face":                          "😬",
+	"grinning_cat":                            "😺",
+	"grinning_cat_with_smiling_eyes":          "😸",
+	"grinning_face":                           "😀",
+	"grinning_face_with_big_eyes":             "😃",
+	"grinning_face_with_smiling_eyes":         "😄",
+	"grinning_face_with_sweat":                "😅",
+	"grinning_squinting_face":                 "😆",
+	"growing_heart":                           "💗",
+	"guard":                                   "💂",
+	"guard_tone1":                             "💂🏻",
+	"guard_tone2":                             "💂🏼",
+	"guard_tone3":                             "💂🏽",
+	"guard_tone4":                             "💂🏾",
+	"guard_tone5":                             "💂🏿",
+	"guide_dog":                               "🦮",
+	"guitar":                                  "🎸",
+	"hair_pick":                               "🪮",
+	"hamburger":                               "🍔",
+	"hammer":                                  "🔨",
+	"hammer_and_pick":                         "⚒️",
+	"hammer_and_wrench":                       "🛠️",
+	"hamsa":                                   "🪬",
+	"hamster":                                 "🐹",
+	"hand_with_fingers_splayed":               "🖐️",
+	"hand_with_fingers_splayed_tone1":         "🖐🏻",
+	"hand_with_fingers_splayed_tone2":         "🖐🏼",
+	"hand_with_fingers_splayed_tone3":         "🖐🏽",
+	"hand_with_fingers_splayed_tone4":         "🖐🏾",
+	"hand_with_fingers_splayed_tone5":         "🖐🏿",
+	"hand_with_index_finger_and_thumb_crossed":       "🫰",
+	"hand_with_index_finger_and_thumb_crossed_tone1": "🫰🏻",
+	"hand_with_index_finger_and_thumb_crossed_tone2": "🫰🏼",
+	"hand_with_index_finger_and_thumb_crossed_tone3": "🫰🏽",
+	"hand_with_index_finger_and_thumb_crossed_tone4": "🫰🏾",
+	"hand_with_index_finger_and_thumb_crossed_tone5": "🫰🏿",
+	"handbag":                                     "👜",
+	"handshake":                                   "🤝",
+	"handshake_tone1":                             "🤝🏻",
+	"handshake_tone1_tone2":                       "🫱🏻\u200d🫲🏼",
+	"handshake_tone1_tone3":                       "🫱🏻\u200d🫲🏽",
+	"handshake_tone1_tone4":                       "🫱🏻\u200d🫲🏾",
+	"handshake_tone1_tone5":                       "🫱🏻\u200d🫲🏿",
+	"handshake_tone2":                             "🤝🏼",
+	"handshake_tone2_tone1":                       "🫱🏼\u200d🫲🏻",
+	"handshake_tone2_tone3":                       "🫱🏼\u200d🫲🏽",
+	"handshake_tone2_tone4":                       "🫱🏼\u200d🫲🏾",
+	"handshake_tone2_tone5":                       "🫱🏼\u200d🫲🏿",
+	"handshake_tone3":                             "🤝🏽",
+	"handshake_tone3_tone1":                       "🫱🏽\u200d🫲🏻",
+	"handshake_tone3_tone2":                       "🫱🏽\u200d🫲🏼",
+	"handshake_tone3_tone4":                       "🫱🏽\u200d🫲🏾",
+	"handshake_tone3_tone5":                       "🫱🏽\u200d🫲🏿",
+	"handshake_tone4":                             "🤝🏾",
+	"handshake_tone4_tone1":                       "🫱🏾\u200d🫲🏻",
+	"handshake_tone4_tone2":                       "🫱🏾\u200d🫲🏼",
+	"handshake_tone4_tone3":                       "🫱🏾\u200d🫲🏽",
+	"handshake_tone4_tone5":                       "🫱🏾\u200d🫲🏿",
+	"handshake_tone5":                             "🤝🏿",
+	"handshake_tone5_tone1":                       "🫱🏿\u200d🫲🏻",
+	"handshake_tone5_tone2":                       "🫱🏿\u200d🫲🏼",
+	"handshake_tone5_tone3":                       "🫱🏿\u200d🫲🏽",
+	"handshake_tone5_tone4":                       "🫱🏿\u200d🫲🏾",
+	"hash":                                        "#️⃣",
+	"hatching_chick":                              "🐣",
+	"head_shaking_horizontally":                   "🙂\u200d↔️",
+	"head_shaking_vertically":                     "🙂\u200d↕️",
+	"headphone":                                   "🎧",
+	"headstone":                                   "🪦",
+	"health_worker":                               "🧑\u200d⚕️",
+	"health_worker_tone1":                         "🧑🏻\u200d⚕️",
+	"health_worker_tone2":                         "🧑🏼\u200d⚕️",
+	"health_worker_tone3":                         "🧑🏽\u200d⚕️",
+	"health_worker_tone4":                         "🧑🏾\u200d⚕️",
+	"health_worker_tone5":                         "🧑🏿\u200d⚕️",
+	"hear_no_evil_monkey":                         "🙉",
+	"heart_decoration":                            "💟",
+	"heart_exclamation":                           "❣️",
+	"heart_hands":                                 "🫶",
+	"heart_hands_tone1":                           "🫶🏻",
+	"heart_hands_tone2":                           "🫶🏼",
+	"heart_hands_tone3":                           "🫶🏽",
+	"heart_hands_tone4":                           "🫶🏾",
+	"heart_hands_tone5":                           "🫶🏿",
+	"heart_on_fire":                               "❤️\u200d🔥",
+	"heart_suit":                                  "♥️",
+	"heart_with_arrow":                            "💘",
+	"heart_with_ribbon":                           "💝",
+	"heavy_dollar_sign":                           "💲",
+	"heavy_equals_sign":                           "🟰",
+	"hedgehog":                                    "🦔",
+	"helicopter":                                  "🚁",
+	"herb":                                        "🌿",
+	"hibiscus":                                    "🌺",
+	"high_heeled_shoe":                            "👠",
+	"high_speed_train":                            "🚄",
+	"high_voltage":                                "⚡",
+	"hiking_boot":                                 "🥾",
+	"hindu_temple":                                "🛕",
+	"hippopotamus":                                "🦛",
+	"hole":                                        "🕳️",
+	"hollow_red_circle":                           "⭕",
+	"honey_pot":                                   "🍯",
+	"honeybee":                                    "🐝",
+	"hook":                                        "🪝",
+	"horizontal_traffic_light":                    "🚥",
+	"horse":                                       "🐎",
+	"horse_face":                                  "🐴",
+	"horse_racing":                                "🏇",
+	"horse_racing_tone1":                          "🏇🏻",
+	"horse_racing_tone2":                          "🏇🏼",
+	"horse_racing_tone3":                          "🏇🏽",
+	"horse_racing_tone4":                          "🏇🏾",
+	"horse_racing_tone5":                          "🏇🏿",
+	"hospital":                                    "🏥",
+	"hot_beverage":                                "☕",
+	"hot_dog":                                     "🌭",
+	"hot_face":                                    "🥵",
+	"hot_pepper":                                  "🌶️",
+	"hot_springs":                                 "♨️",
+	"hotel":                                       "🏨",
+	"hourglass_done":                              "⌛",
+	"hourglass_not_done":                          "⏳",
+	"house":                                       "🏠",
+	"house_with_garden":                           "🏡",
+	"houses":                                      "🏘️",
+	"hundred_points":                              "💯",
+	"hushed_face":                                 "😯",
+	"hut":                                         "🛖",
+	"hyacinth":                                    "🪻",
+	"ice":                                         "🧊",
+	"ice_cream":                                   "🍨",
+	"ice_hockey":                                  "🏒",
+	"ice_skate":                                   "⛸️",
+	"id_button":                                   "🆔",
+	"identification_card":                         "🪪",
+	"inbox_tray":                                  "📥",
+	"incoming_envelope":                           "📨",
+	"index_pointing_at_the_viewer":                "🫵",
+	"index_pointing_at_the_viewer_tone1":          "🫵🏻",
+	"index_pointing_at_the_viewer_tone2":          "🫵🏼",
+	"index_pointing_at_the_viewer_tone3":          "🫵🏽",
+	"index_pointing_at_the_viewer_tone4":          "🫵🏾",
+	"index_pointing_at_the_viewer_tone5":          "🫵🏿",
+	"index_pointing_up":                           "☝️",
+	"index_pointing_up_tone1":                     "☝🏻",
+	"index_pointing_up_tone2":                     "☝🏼",
+	"index_pointing_up_tone3":                     "☝🏽",
+	"index_pointing_up_tone4":                     "☝🏾",
+	"index_pointing_up_tone5":                     "☝🏿",
+	"infinity":                                    "♾️",
+	"information":                                 "ℹ️",
+	"input_latin_letters":                         "🔤",
+	"input_latin_lowercase":                       "🔡",
+	"input_latin_uppercase":                       "🔠",
+	"input_numbers":                               "🔢",
+	"input_symbols":                               "🔣",
+	"jack_o_lantern":                              "🎃",
+	"japanese_acceptable_button":                  "🉑",
+	"japanese_application_button":                 "🈸",
+	"japanese_bargain_button":                     "🉐",
+	"japanese_castle":                             "🏯",
+	"japanese_congratulations_button":             "㊗️",
+	"japanese_discount_button":                    "🈹",
+	"japanese_dolls":                              "🎎",
+	"japanese_free_of_charge_button":              "🈚",
+	"japanese_here_button":                        "🈁",
+	"japanese_monthly_amount_button":              "🈷️",
+	"japanese_no_vacancy_button":                  "🈵",
+	"japanese_not_free_of_charge_button":          "🈶",
+	"japanese_open_for_business_button":           "🈺",
+	"japanese_passing_grade_button":               "🈴",
+	"japanese_post_office":                        "🏣",
+	"japanese_prohibited_button":                  "🈲",
+	"japanese_reserved_button":                    "🈯",
+	"japanese_secret_button":                      "㊙️",
+	"japanese_service_charge_button":              "🈂️",
+	"japanese_symbol_for_beginner":                "🔰",
+	"japanese_vacancy_button":                     "🈳",
+	"jar":                                         "🫙",
+	"jeans":                                       "👖",
+	"jellyfish":                                   "🪼",
+	"joker":                                       "🃏",
+	"joystick":                                    "🕹️",
+	"judge":                                       "🧑\u200d⚖️",
+	"judge_tone1":                                 "🧑🏻\u200d⚖️",
+	"judge_tone2":                                 "🧑🏼\u200d⚖️",
+	"judge_tone3":                                 "🧑🏽\u200d⚖️",
+	"judge_tone4":                                 "🧑🏾\u200d⚖️",
+	"judge_tone5":                                 "🧑🏿\u200d⚖️",
+	"kaaba":                                       "🕋",
+	"kangaroo":                                    "🦘",
+	"key":                                         "🔑",
+	"keyboard":                                    "⌨️",
+	"keycap_ten":                                  "🔟",
+	"khanda":                                      "🪯",
+	"kick_scooter":                                "🛴",
+	"kimono":                                      "👘",
+	"kiss":                                        "💏",
+	"kiss_man_man":                                "👨\u200d❤️\u200d💋\u200d👨",
+	"kiss_man_man_tone1":                          "👨🏻\u200d❤️\u200d💋\u200d👨🏻",
+	"kiss_man_man_tone1_tone2":                    "👨🏻\u200d❤️\u200d💋\u200d👨🏼",
+	"kiss_man_man_tone1_tone3":                    "👨🏻\u200d❤️\u200d💋\u200d👨🏽",
+	"kiss_man_man_tone1_tone4":                    "👨🏻\u200d❤️\u200d💋\u200d👨🏾",
+	"kiss_man_man_tone1_tone5":                    "👨🏻\u200d❤️\u200d💋\u200d👨🏿",
+	"kiss_man_man_tone2":                          "👨🏼\u200d❤️\u200d💋\u200d👨🏼",
+	"kiss_man_man_tone2_tone1":                    "👨🏼\u200d❤️\u200d💋\u200d👨🏻",
+	"kiss_man_man_tone2_tone3":                    "👨🏼\u200d❤️\u200d💋\u200d👨🏽",
+	"kiss_man_man_tone2_tone4":                    "👨🏼\u200d❤️\u200d💋\u200d👨🏾",
+	"kiss_man_man_tone2_tone5":                    "👨🏼\u200d❤️\u200d💋\u200d👨🏿",
+	"kiss_man_man_tone3":                          "👨🏽\u200d❤️\u200d💋\u200d👨🏽",
+	"kiss_man_man_tone3_tone1":                    "👨🏽\u200d❤️\u200d💋\u200d👨🏻",
+	"kiss_man_man_tone3_tone2":                    "👨🏽\u200d❤️\u200d💋\u200d👨🏼",
+	"kiss_man_man_tone3_tone4":                    "👨🏽\u200d❤️\u200d💋\u200d👨🏾",
+	"kiss_man_man_tone3_tone5":                    "👨🏽\u200d❤️\u200d💋\u200d👨🏿",
+	"kiss_man_man_tone4":                          "👨🏾\u200d❤️\u200d💋\u200d👨🏾",
+	"kiss_man_man_tone4_tone1":                    "👨🏾\u200d❤️\u200d💋\u200d👨🏻",
+	"kiss_man_man_tone4_tone2":                    "👨🏾\u200d❤️\u200d💋\u200d👨🏼",
+	"kiss_man_man_tone4_tone3":                    "👨🏾\u200d❤️\u200d💋\u200d👨🏽",
+	"kiss_man_man_tone4_tone5":                    "👨🏾\u200d❤️\u200d💋\u200d👨🏿",
+	"kiss_man_man_tone5":                          "👨🏿\u200d❤️\u200d💋\u200d👨🏿",
+	"kiss_man_man_tone5_tone1":                    "👨🏿\u200d❤️\u200d💋\u200d👨🏻",
+	"kiss_man_man_tone5_tone2":                    "👨🏿\u200d❤️\u200d💋\u200d👨🏼",
+	"kiss_man_man_tone5_tone3":                    "👨🏿\u200d❤️\u200d💋\u200d👨🏽",
+	"kiss_man_man_tone5_tone4":                    "👨🏿\u200d❤️\u200d💋\u200d👨🏾",
+	"kiss_mark":                                   "💋",
+	"kiss_person_person_tone1_tone2":              "🧑🏻\u200d❤️\u200d💋\u200d🧑🏼",
+	"kiss_person_person_tone1_tone3":              "🧑🏻\u200d❤️\u200d💋\u200d🧑🏽",
+	"kiss_person_person_tone1_tone4":              "🧑🏻\u200d❤️\u200d💋\u200d🧑🏾",
+	"kiss_person_person_tone1_tone5":              "🧑🏻\u200d❤️\u200d💋\u200d🧑🏿",
+	"kiss_person_person_tone2_tone1":              "🧑🏼\u200d❤️\u200d💋\u200d🧑🏻",
+	"kiss_person_person_tone2_tone3":              "🧑🏼\u200d❤️\u200d💋\u200d🧑🏽",
+	"kiss_person_person_tone2_tone4":              "🧑🏼\u200d❤️\u200d💋\u200d🧑🏾",
+	"kiss_person_person_tone2_tone5":              "🧑🏼\u200d❤️\u200d💋\u200d🧑🏿",
+	"kiss_person_person_tone3_tone1":              "🧑🏽\u200d❤️\u200d💋\u200d🧑🏻",
+	"kiss_person_person_tone3_tone2":              "🧑🏽\u200d❤️\u200d💋\u200d🧑🏼",
+	"kiss_person_person_tone3_tone4":              "🧑🏽\u200d❤️\u200d💋\u200d🧑🏾",
+	"kiss_person_person_tone3_tone5":              "🧑🏽\u200d❤️\u200d💋\u200d🧑🏿",
+	"kiss_person_person_tone4_tone1":              "🧑🏾\u200d❤️\u200d💋\u200d🧑🏻",
+	"kiss_person_person_tone4_tone2":              "🧑🏾\u200d❤️\u200d💋\u200d🧑🏼",
+	"kiss_person_person_tone4_tone3":              "🧑🏾\u200d❤️\u200d💋\u200d🧑🏽",
+	"kiss_person_person_tone4_tone5":              "🧑🏾\u200d❤️\u200d💋\u200d🧑🏿",
+	"kiss_person_person_tone5_tone1":              "🧑🏿\u200d❤️\u200d💋\u200d🧑🏻",
+	"kiss_person_person_tone5_tone2":              "🧑🏿\u200d❤️\u200d💋\u200d🧑🏼",
+	"kiss_person_person_tone5_tone3":              "🧑🏿\u200d❤️\u200d💋\u200d🧑🏽",
+	"kiss_person_person_tone5_tone4":              "🧑🏿\u200d❤️\u200d💋\u200d🧑🏾",
+	"kiss_tone1":                                  "💏🏻",
+	"kiss_tone2":                                  "💏🏼",
+	"kiss_tone3":                                  "💏🏽",
+	"kiss_tone4":                                  "💏🏾",
+	"kiss_tone5":                                  "💏🏿",
+	"kiss_woman_man":                              "👩\u200d❤️\u200d💋\u200d👨",
+	"kiss_woman_man_tone1":                        "👩🏻\u200d❤️\u200d💋\u200d👨🏻",
+	"kiss_woman_man_tone1_tone2":                  "👩🏻\u200d❤️\u200d💋\u200d👨🏼",
+	"kiss_woman_man_tone1_tone3":                  "👩🏻\u200d❤️\u200d💋\u200d👨🏽",
+	"kiss_woman_man_tone1_tone4":                  "👩🏻\u200d❤️\u200d💋\u200d👨🏾",
+	"kiss_woman_man_tone1_tone5":                  "👩🏻\u200d❤️\u200d💋\u200d👨🏿",
+	"kiss_woman_man_tone2":                        "👩🏼\u200d❤️\u200d💋\u200d👨🏼",
+	"kiss_woman_man_tone2_tone1":                  "👩🏼\u200d❤️\u200d💋\u200d👨🏻",
+	"kiss_woman_man_tone2_tone3":                  "👩🏼\u200d❤️\u200d💋\u200d👨🏽",
+	"kiss_woman_man_tone2_tone4":                  "👩🏼\u200d❤️\u200d💋\u200d👨🏾",
+	"kiss_woman_man_tone2_tone5":                  "👩🏼\u200d❤️\u200d💋\u200d👨🏿",
+	"kiss_woman_man_tone3":                        "👩🏽\u200d❤️\u200d💋\u200d👨🏽",
+	"kiss_woman_man_tone3_tone1":                  "👩🏽\u200d❤️\u200d💋\u200d👨🏻",
+	"kiss_woman_man_tone3_tone2":                  "👩🏽\u200d❤️\u200d💋\u200d👨🏼",
+	"kiss_woman_man_tone3_tone4":                  "👩🏽\u200d❤️\u200d💋\u200d👨🏾",
+	"kiss_woman_man_tone3_tone5":                  "👩🏽\u200d❤️\u200d💋\u200d👨🏿",
+	"kiss_woman_man_tone4":                        "👩🏾\u200d❤️\u200d💋\u200d👨🏾",
+	"kiss_woman_man_tone4_tone1":                  "👩🏾\u200d❤️\u200d💋\u200d👨🏻",
+	"kiss_woman_man_tone4_tone2":                  "👩🏾\u200d❤️\u200d💋\u200d👨🏼",
+	"kiss_woman_man_tone4_tone3":                  "👩🏾\u200d❤️\u200d💋\u200d👨🏽",
+	"kiss_woman_man_tone4_tone5":                  "👩🏾\u200d❤️\u200d💋\u200d👨🏿",
+	"kiss_woman_man_tone5":                        "👩🏿\u200d❤️\u200d💋\u200d👨🏿",
+	"kiss_woman_man_tone5_tone1":                  "👩🏿\u200d❤️\u200d💋\u200d👨🏻",
+	"kiss_woman_man_tone5_tone2":                  "👩🏿\u200d❤️\u200d💋\u200d👨🏼",
+	"kiss_woman_man_tone5_tone3":                  "👩🏿\u200d❤️\u200d💋\u200d👨🏽",
+	"kiss_woman_man_tone5_tone4":                  "👩🏿\u200d❤️\u200d💋\u200d👨🏾",
+	"kiss_woman_woman":                            "👩\u200d❤️\u200d💋\u200d👩",
+	"kiss_woman_woman_tone1":                      "👩🏻\u200d❤️\u200d💋\u200d👩🏻",
+	"kiss_woman_woman_tone1_tone2":                "👩🏻\u200d❤️\u200d💋\u200d👩🏼",
+	"kiss_woman_woman_tone1_tone3":                "👩🏻\u200d❤️\u200d💋\u200d👩🏽",
+	"kiss_woman_woman_tone1_tone4":                "👩🏻\u200d❤️\u200d💋\u200d👩🏾",
+	"kiss_woman_woman_tone1_tone5":                "👩🏻\u200d❤️\u200d💋\u200d👩🏿",
+	"kiss_woman_woman_tone2":                      "👩🏼\u200d❤️\u200d💋\u200d👩🏼",
+	"kiss_woman_woman_tone2_tone1":                "👩🏼\u200d❤️\u200d💋\u200d👩🏻",
+	"kiss_woman_woman_tone2_tone3":                "👩🏼\u200d❤️\u200d💋\u200d👩🏽",
+	"kiss_woman_woman_tone2_tone4":                "👩🏼\u200d❤️\u200d💋\u200d👩🏾",
+	"kiss_woman_woman_tone2_tone5":                "👩🏼\u200d❤️\u200d💋\u200d👩🏿",
+	"kiss_woman_woman_tone3":                      "👩🏽\u200d❤️\u200d💋\u200d👩🏽",
+	"kiss_woman_woman_tone3_tone1":                "👩🏽\u200d❤️\u200d💋\u200d👩🏻",
+	"kiss_woman_woman_tone3_tone2":                "👩🏽\u200d❤️\u200d💋\u200d👩🏼",
+	"kiss_woman_woman_tone3_tone4":                "👩🏽\u200d❤️\u200d💋\u200d👩🏾",
+	"kiss_woman_woman_tone3_tone5":                "👩🏽\u200d❤️\u200d💋\u200d👩🏿",
+	"kiss_woman_woman_tone4":                      "👩🏾\u200d❤️\u200d💋\u200d👩🏾",
+	"kiss_woman_woman_tone4_tone1":                "👩🏾\u200d❤️\u200d💋\u200d👩🏻",
+	"kiss_woman_woman_tone4_tone2":                "👩🏾\u200d❤️\u200d💋\u200d👩🏼",
+	"kiss_woman_woman_tone4_tone3":                "👩🏾\u200d❤️\u200d💋\u200d👩🏽",
+	"kiss_woman_woman_tone4_tone5":                "👩🏾\u200d❤️\u200d💋\u200d👩🏿",
+	"kiss_woman_woman_tone5":                      "👩🏿\u200d❤️\u200d💋\u200d👩🏿",
+	"kiss_woman_woman_tone5_tone1":                "👩🏿\u200d❤️\u200d💋\u200d👩🏻",
+	"kiss_woman_woman_tone5_tone2":                "👩🏿\u200d❤️\u200d💋\u200d👩🏼",
+	"kiss_woman_woman_tone5_tone3":                "👩🏿\u200d❤️\u200d💋\u200d👩🏽",
+	"kiss_woman_woman_tone5_tone4":                "👩🏿\u200d❤️\u200d💋\u200d👩🏾",
+	"kissing_cat":                                 "😽",
+	"kissing_face":                                "😗",
+	"kissing_face_with_closed_eyes":               "😚",
+	"kissing_face_with_smiling_eyes":              "😙",
+	"kitchen_knife":                               "🔪",
+	"kite":                                        "🪁",
+	"kiwi_fruit":                                  "🥝",
+	"knot":                                        "🪢",
+	"koala":                                       "🐨",
+	"lab_coat":                                    "🥼",
+	"label":                                       "🏷️",
+	"lacrosse":                                    "🥍",
+	"ladder":                                      "🪜",
+	"lady_beetle":                                 "🐞",
+	"laptop":                                      "💻",
+	"large_blue_diamond":                          "🔷",
+	"large_orange_diamond":                        "🔶",
+	"last_quarter_moon":                           "🌗",
+	"last_quarter_moon_face":                      "🌜",
+	"last_track_button":                           "⏮️",
+	"latin_cross":                                 "✝️",
+	"leaf_fluttering_in_wind":                     "🍃",
+	"leafy_green":                                 "🥬",
+	"ledger":                                      "📒",
+	"left_arrow":                                  "⬅️",
+	"left_arrow_curving_right":                    "↪️",
+	"left_facing_fist":                            "🤛",
+	"left_facing_fist_tone1":                      "🤛🏻",
+	"left_facing_fist_tone2":                      "🤛🏼",
+	"left_facing_fist_tone3":                      "🤛🏽",
+	"left_facing_fist_tone4":                      "🤛🏾",
+	"left_facing_fist_tone5":                      "🤛🏿",
+	"left_luggage":                                "🛅",
+	"left_right_arrow":                            "↔️",
+	"left_speech_bubble":                          "🗨️",
+	"leftwards_hand":                              "🫲",
+	"leftwards_hand_tone1":                        "🫲🏻",
+	"leftwards_hand_tone2":                        "🫲🏼",
+	"leftwards_hand_tone3":                        "🫲🏽",
+	"leftwards_hand_tone4":                        "🫲🏾",
+	"leftwards_hand_tone5":                        "🫲🏿",
+	"leftwards_pushing_hand":                      "🫷",
+	"leftwards_pushing_hand_tone1":                "🫷🏻",
+	"leftwards_pushing_hand_tone2":                "🫷🏼",
+	"leftwards_pushing_hand_tone3":                "🫷🏽",
+	"leftwards_pushing_hand_tone4":                "🫷🏾",
+	"leftwards_pushing_hand_tone5":                "🫷🏿",
+	"leg":                                         "🦵",
+	"leg_tone1":                                   "🦵🏻",
+	"leg_tone2":                                   "🦵🏼",
+	"leg_tone3":                                   "🦵🏽",
+	"leg_tone4":                                   "🦵🏾",
+	"leg_tone5":                                   "🦵🏿",
+	"lemon":                                       "🍋",
+	"leo":                                         "♌",
+	"leopard":                                     "🐆",
+	"level_slider":                                "🎚️",
+	"libra":                                       "♎",
+	"light_blue_heart":                            "🩵",
+	"light_bulb":                                  "💡",
+	"light_rail":                                  "🚈",
+	"lime":                                        "🍋\u200d🟩",
+	"link":                                        "🔗",
+	"linked_paperclips":                           "🖇️",
+	"lion":                                        "🦁",
+	"lipstick":                                    "💄",
+	"litter_in_bin_sign":                          "🚮",
+	"lizard":                                      "🦎",
+	"llama":                                       "🦙",
+	"lobster":                                     "🦞",
+	"locked":                                      "🔒",
+	"locked_with_key":                             "🔐",
+	"locked_with_pen":                             "🔏",
+	"locomotive":                                  "🚂",
+	"lollipop":                                    "🍭",
+	"long_drum":                                   "🪘",
+	"lotion_bottle":                               "🧴",
+	"lotus":                                       "🪷",
+	"loudly_crying_face":                          "😭",
+	"loudspeaker":                                 "📢",
+	"love_hotel":                                  "🏩",
+	"love_letter":                                 "💌",
+	"love_you_gesture":                            "🤟",
+	"love_you_gesture_tone1":                      "🤟🏻",
+	"love_you_gesture_tone2":                      "🤟🏼",
+	"love_you_gesture_tone3":                      "🤟🏽",
+	"love_you_gesture_tone4":                      "🤟🏾",
+	"love_you_gesture_tone5":                      "🤟🏿",
+	"low_battery":                                 "🪫",
+	"luggage":                                     "🧳",
+	"lungs":                                       "🫁",
+	"lying_face":                                  "🤥",
+	"mage":                                        "🧙",
+	"mage_tone1":                                  "🧙🏻",
+	"mage_tone2":                                  "🧙🏼",
+	"mage_tone3":                                  "🧙🏽",
+	"mage_tone4":                                  "🧙🏾",
+	"mage_tone5":                                  "🧙🏿",
+	"magic_wand":                                  "🪄",
+	"magnet":                                      "🧲",
+	"magnifying_glass_tilted_left":                "🔍",
+	"magnifying_glass_tilted_right":               "🔎",
+	"mahjong_red_dragon":                          "🀄",
+	"male_sign":                                   "♂️",
+	"mammoth":                                     "🦣",
+	"man":                                         "👨",
+	"man_artist":                                  "👨\u200d🎨",
+	"man_artist_tone1":                            "👨🏻\u200d🎨",
+	"man_artist_tone2":                            "👨🏼\u200d🎨",
+	"man_artist_tone3":                            "👨🏽\u200d🎨",
+	"man_artist_tone4":                            "👨🏾\u200d🎨",
+	"man_artist_tone5":                            "👨🏿\u200d🎨",
+	"man_astronaut":                               "👨\u200d🚀",
+	"man_astronaut_tone1":                         "👨🏻\u200d🚀",
+	"man_astronaut_tone2":                         "👨🏼\u200d🚀",
+	"man_astronaut_tone3":                         "👨🏽\u200d🚀",
+	"man_astronaut_tone4":                         "👨🏾\u200d🚀",
+	"man_astronaut_tone5":                         "👨🏿\u200d🚀",
+	"man_bald":                                    "👨\u200d🦲",
+	"man_beard":                                   "🧔\u200d♂️",
+	"man_biking":                                  "🚴\u200d♂️",
+	"man_biking_tone1":                            "🚴🏻\u200d♂️",
+	"man_biking_tone2":                            "🚴🏼\u200d♂️",
+	"man_biking_tone3":                            "🚴🏽\u200d♂️",
+	"man_biking_tone4":                            "🚴🏾\u200d♂️",
+	"man_biking_tone5":                            "🚴🏿\u200d♂️",
+	"man_blond_hair":                              "👱\u200d♂️",
+	"man_bouncing_ball":                           "⛹️\u200d♂️",
+	"man_bouncing_ball_tone1":                     "⛹🏻\u200d♂️",
+	"man_bouncing_ball_tone2":                     "⛹🏼\u200d♂️",
+	"man_bouncing_ball_tone3":                     "⛹🏽\u200d♂️",
+	"man_bouncing_ball_tone4":                     "⛹🏾\u200d♂️",
+	"man_bouncing_ball_tone5":                     "⛹🏿\u200d♂️",
+	"man_bowing":                                  "🙇\u200d♂️",
+	"man_bowing_tone1":                            "🙇🏻\u200d♂️",
+	"man_bowing_tone2":                            "🙇🏼\u200d♂️",
+	"man_bowing_tone3":                            "🙇🏽\u200d♂️",
+	"man_bowing_tone4":                            "🙇🏾\u200d♂️",
+	"man_bowing_tone5":                            "🙇🏿\u200d♂️",
+	"man_cartwheeling":                            "🤸\u200d♂️",
+	"man_cartwheeling_tone1":                      "🤸🏻\u200d♂️",
+	"man_cartwheeling_tone2":                      "🤸🏼\u200d♂️",
+	"man_cartwheeling_tone3":                      "🤸🏽\u200d♂️",
+	"man_cartwheeling_tone4":                      "🤸🏾\u200d♂️",
+	"man_cartwheeling_tone5":                      "🤸🏿\u200d♂️",
+	"man_climbing":                                "🧗\u200d♂️",
+	"man_climbing_tone1":                          "🧗🏻\u200d♂️",
+	"man_climbing_tone2":                          "🧗🏼\u200d♂️",
+	"man_climbing_tone3":                          "🧗🏽\u200d♂️",
+	"man_climbing_tone4":                          "🧗🏾\u200d♂️",
+	"man_climbing_tone5":                          "🧗🏿\u200d♂️",
+	"man_construction_worker":                     "👷\u200d♂️",
+	"man_construction_worker_tone1":               "👷🏻\u200d♂️",
+	"man_construction_worker_tone2":               "👷🏼\u200d♂️",
+	"man_construction_worker_tone3":               "👷🏽\u200d♂️",
+	"man_construction_worker_tone4":               "👷🏾\u200d♂️",
+	"man_construction_worker_tone5":               "👷🏿\u200d♂️",
+	"man_cook":                                    "👨\u200d🍳",
+	"man_cook_tone1":                              "👨🏻\u200d🍳",
+	"man_cook_tone2":                              "👨🏼\u200d🍳",
+	"man_cook_tone3":                              "👨🏽\u200d🍳",
+	"man_cook_tone4":                              "👨🏾\u200d🍳",
+	"man_cook_tone5":                              "👨🏿\u200d🍳",
+	"man_curly_hair":                              "👨\u200d🦱",
+	"man_dancing":                                 "🕺",
+	"man_dancing_tone1":                           "🕺🏻",
+	"man_dancing_tone2":                           "🕺🏼",
+	"man_dancing_tone3":                           "🕺🏽",
+	"man_dancing_tone4":                           "🕺🏾",
+	"man_dancing_tone5":                           "🕺🏿",
+	"man_detective":                               "🕵️\u200d♂️",
+	"man_detective_tone1":                         "🕵🏻\u200d♂️",
+	"man_detective_tone2":                         "🕵🏼\u200d♂️",
+	"man_detective_tone3":                         "🕵🏽\u200d♂️",
+	"man_detective_tone4":                         "🕵🏾\u200d♂️",
+	"man_detective_tone5":                         "🕵🏿\u200d♂️",
+	"man_elf":                                     "🧝\u200d♂️",
+	"man_elf_tone1":                               "🧝🏻\u200d♂️",
+	"man_elf_tone2":                               "🧝🏼\u200d♂️",
+	"man_elf_tone3":                               "🧝🏽\u200d♂️",
+	"man_elf_tone4":                               "🧝🏾\u200d♂️",
+	"man_elf_tone5":                               "🧝🏿\u200d♂️",
+	"man_facepalming":                             "🤦\u200d♂️",
+	"man_facepalming_tone1":                       "🤦🏻\u200d♂️",
+	"man_facepalming_tone2":                       "🤦🏼\u200d♂️",
+	"man_facepalming_tone3":                       "🤦🏽\u200d♂️",
+	"man_facepalming_tone4":                       "🤦🏾\u200d♂️",
+	"man_facepalming_tone5":                       "🤦🏿\u200d♂️",
+	"man_factory_worker":                          "👨\u200d🏭",
+	"man_factory_worker_tone1":                    "👨🏻\u200d🏭",
+	"man_factory_worker_tone2":                    "👨🏼\u200d🏭",
+	"man_factory_worker_tone3":                    "👨🏽\u200d🏭",
+	"man_factory_worker_tone4":                    "👨🏾\u200d🏭",
+	"man_factory_worker_tone5":                    "👨🏿\u200d🏭",
+	"man_fairy":                                   "🧚\u200d♂️",
+	"man_fairy_tone1":                             "🧚🏻\u200d♂️",
+	"man_fairy_tone2":                             "🧚🏼\u200d♂️",
+	"man_fairy_tone3":                             "🧚🏽\u200d♂️",
+	"man_fairy_tone4":                             "🧚🏾\u200d♂️",
+	"man_fairy_tone5":                             "🧚🏿\u200d♂️",
+	"man_farmer":                                  "👨\u200d🌾",
+	"man_farmer_tone1":                            "👨🏻\u200d🌾",
+	"man_farmer_tone2":                            "👨🏼\u200d🌾",
+	"man_farmer_tone3":                            "👨🏽\u200d🌾",
+	"man_farmer_tone4":                            "👨🏾\u200d🌾",
+	"man_farmer_tone5":                            "👨🏿\u200d🌾",
+	"man_feeding_baby":                            "👨\u200d🍼",
+	"man_feeding_baby_tone1":                      "👨🏻\u200d🍼",
+	"man_feeding_baby_tone2":                      "👨🏼\u200d🍼",
+	"man_feeding_baby_tone3":                      "👨🏽\u200d🍼",
+	"man_feeding_baby_tone4":                      "👨🏾\u200d🍼",
+	"man_feeding_baby_tone5":                      "👨🏿\u200d🍼",
+	"man_firefighter":                             "👨\u200d🚒",
+	"man_firefighter_tone1":                       "👨🏻\u200d🚒",
+	"man_firefighter_tone2":                       "👨🏼\u200d🚒",
+	"man_firefighter_tone3":                       "👨🏽\u200d🚒",
+	"man_firefighter_tone4":                       "👨🏾\u200d🚒",
+	"man_firefighter_tone5":                       "👨🏿\u200d🚒",
+	"man_frowning":                                "🙍\u200d♂️",
+	"man_frowning_tone1":                          "🙍🏻\u200d♂️",
+	"man_frowning_tone2":                          "🙍🏼\u200d♂️",
+	"man_frowning_tone3":                          "🙍🏽\u200d♂️",
+	"man_frowning_tone4":                          "🙍🏾\u200d♂️",
+	"man_frowning_tone5":                          "🙍🏿\u200d♂️",
+	"man_genie":                                   "🧞\u200d♂️",
+	"man_gesturing_no":                            "🙅\u200d♂️",
+	"man_gesturing_no_tone1":                      "🙅🏻\u200d♂️",
+	"man_gesturing_no_tone2":                      "🙅🏼\u200d♂️",
+	"man_gesturing_no_tone3":                      "🙅🏽\u200d♂️",
+	"man_gesturing_no_tone4":                      "🙅🏾\u200d♂️",
+	"man_gesturing_no_tone5":                      "🙅🏿\u200d♂️",
+	"man_gesturing_ok":                            "🙆\u200d♂️",
+	"man_gesturing_ok_tone1":                      "🙆🏻\u200d♂️",
+	"man_gesturing_ok_tone2":                      "🙆🏼\u200d♂️",
+	"man_gesturing_ok_tone3":                      "🙆🏽\u200d♂️",
+	"man_gesturing_ok_tone4":                      "🙆🏾\u200d♂️",
+	"man_gesturing_ok_tone5":                      "🙆🏿\u200d♂️",
+	"man_getting_haircut":                         "💇\u200d♂️",
+	"man_getting_haircut_tone1":                   "💇🏻\u200d♂️",
+	"man_getting_haircut_tone2":                   "💇🏼\u200d♂️",
+	"man_getting_haircut_tone3":                   "💇🏽\u200d♂️",
+	"man_getting_haircut_tone4":                   "💇🏾\u200d♂️",
+	"man_getting_haircut_tone5":                   "💇🏿\u200d♂️",
+	"man_getting_massage":                         "💆\u200d♂️",
+	"man_getting_massage_tone1":                   "💆🏻\u200d♂️",
+	"man_getting_massage_tone2":                   "💆🏼\u200d♂️",
+	"man_getting_massage_tone3":                   "💆🏽\u200d♂️",
+	"man_getting_massage_tone4":                   "💆🏾\u200d♂️",
+	"man_getting_massage_tone5":                   "💆🏿\u200d♂️",
+	"man_golfing":                                 "🏌️\u200d♂️",
+	"man_golfing_tone1":                           "🏌🏻\u200d♂️",
+	"man_golfing_tone2":                           "🏌🏼\u200d♂️",
+	"man_golfing_tone3":                           "🏌🏽\u200d♂️",
+	"man_golfing_tone4":                           "🏌🏾\u200d♂️",
+	"man_golfing_tone5":                           "🏌🏿\u200d♂️",
+	"man_guard":                                   "💂\u200d♂️",
+	"man_guard_tone1":                             "💂🏻\u200d♂️",
+	"man_guard_tone2":                             "💂🏼\u200d♂️",
+	"man_guard_tone3":                             "💂🏽\u200d♂️",
+	"man_guard_tone4":                             "💂🏾\u200d♂️",
+	"man_guard_tone5":                             "💂🏿\u200d♂️",
+	"man_health_worker":                           "👨\u200d⚕️",
+	"man_health_worker_tone1":                     "👨🏻\u200d⚕️",
+	"man_health_worker_tone2":                     "👨🏼\u200d⚕️",
+	"man_health_worker_tone3":                     "👨🏽\u200d⚕️",
+	"man_health_worker_tone4":                     "👨🏾\u200d⚕️",
+	"man_health_worker_tone5":                     "👨🏿\u200d⚕️",
+	"man_in_lotus_position":                       "🧘\u200d♂️",
+	"man_in_lotus_position_tone1":                 "🧘🏻\u200d♂️",
+	"man_in_lotus_position_tone2":                 "🧘🏼\u200d♂️",
+	"man_in_lotus_position_tone3":                 "🧘🏽\u200d♂️",
+	"man_in_lotus_position_tone4":                 "🧘🏾\u200d♂️",
+	"man_in_lotus_position_tone5":                 "🧘🏿\u200d♂️",
+	"man_in_manual_wheelchair":                    "👨\u200d🦽",
+	"man_in_manual_wheelchair_facing_right":       "👨\u200d🦽\u200d➡️",
+	"man_in_manual_wheelchair_facing_right_tone1": "👨🏻\u200d🦽\u200d➡️",
+	"man_in_manual_wheelchair_facing_right_tone2": "👨🏼\u200d🦽\u200d➡️",
+	"man_in_manual_wheelchair_facing_right_tone3": "👨🏽\u200d🦽\u200d➡️",
+	"man_in_manual_wheelchair_facing_right_tone4": "👨🏾\u200d🦽\u200d➡️",
+	"man_in_manual_wheelchair_facing_right_tone5": "👨🏿\u200d🦽\u200d➡️",
+	"man_in_manual_wheelchair_tone1":              "👨🏻\u200d🦽",
+	"man_in_manual_wheelchair_tone2":              "👨🏼\u200d🦽",
+	"man_in_manual_wheelchair_tone3":              "👨🏽\u200d🦽",
+	"man_in_manual_wheelchair_tone4":              "👨🏾\u200d🦽",
+	"man_in_manual_wheelchair_tone5":              "👨🏿\u200d🦽",
+	"man_in_motorized_wheelchair":                 "👨\u200d🦼",
+	"man_in_motorized_wheelchair_facing_right":    "👨\u200d🦼\u200d➡️",
+	"man_in_motorized_wheelchair_facing_right_tone1": "👨🏻\u200d🦼\u200d➡️",
+	"man_in_motorized_wheelchair_facing_right_tone2": "👨🏼\u200d🦼\u200d➡️",
+	"man_in_motorized_wheelchair_facing_right_tone3": "👨🏽\u200d🦼\u200d➡️",
+	"man_in_motorized_wheelchair_facing_right_tone4": "👨🏾\u200d🦼\u200d➡️",
+	"man_in_motorized_wheelchair_facing_right_tone5": "👨🏿\u200d🦼\u200d➡️",
+	"man_in_motorized_wheelchair_tone1":              "👨🏻\u200d🦼",
+	"man_in_motorized_wheelchair_tone2":              "👨🏼\u200d🦼",
+	"man_in_motorized_wheelchair_tone3":              "👨🏽\u200d🦼",
+	"man_in_motorized_wheelchair_tone4":              "👨🏾\u200d🦼",
+	"man_in_motorized_wheelchair_tone5":              "👨🏿\u200d🦼",
+	"man_in_steamy_room":                             "🧖\u200d♂️",
+	"man_in_steamy_room_tone1":                       "🧖🏻\u200d♂️",
+	"man_in_steamy_room_tone2":                       "🧖🏼\u200d♂️",
+	"man_in_steamy_room_tone3":                       "🧖🏽\u200d♂️",
+	"man_in_steamy_room_tone4":                       "🧖🏾\u200d♂️",
+	"man_in_steamy_room_tone5":                       "🧖🏿\u200d♂️",
+	"man_in_tuxedo":                                  "🤵\u200d♂️",
+	"man_in_tuxedo_tone1":                            "🤵🏻\u200d♂️",
+	"man_in_tuxedo_tone2":                            "🤵🏼\u200d♂️",
+	"man_in_tuxedo_tone3":                            "🤵🏽\u200d♂️",
+	"man_in_tuxedo_tone4":                            "🤵🏾\u200d♂️",
+	"man_in_tuxedo_tone5":                            "🤵🏿\u200d♂️",
+	"man_judge":                                      "👨\u200d⚖️",
+	"man_judge_tone1":                                "👨🏻\u200d⚖️",
+	"man_judge_tone2":                                "👨🏼\u200d⚖️",
+	"man_judge_tone3":                                "👨🏽\u200d⚖️",
+	"man_judge_tone4":                                "👨🏾\u200d⚖️",
+	"man_judge_tone5":                                "👨🏿\u200d⚖️",
+	"man_juggling":                                   "🤹\u200d♂️",
+	"man_juggling_tone1":                             "🤹🏻\u200d♂️",
+	"man_juggling_tone2":                             "🤹🏼\u200d♂️",
+	"man_juggling_tone3":                             "🤹🏽\u200d♂️",
+	"man_juggling_tone4":                             "🤹🏾\u200d♂️",
+	"man_juggling_tone5":                             "🤹🏿\u200d♂️",
+	"man_kneeling":                                   "🧎\u200d♂️",
+	"man_kneeling_facing_right":                      "🧎\u200d♂️\u200d➡️",
+	"man_kneeling_facing_right_tone1":                "🧎🏻\u200d♂️\u200d➡️",
+	"man_kneeling_facing_right_tone2":                "🧎🏼\u200d♂️\u200d➡️",
+	"man_kneeling_facing_right_tone3":                "🧎🏽\u200d♂️\u200d➡️",
+	"man_kneeling_facing_right_tone4":                "🧎🏾\u200d♂️\u200d➡️",
+	"man_kneeling_facing_right_tone5":                "🧎🏿\u200d♂️\u200d➡️",
+	"man_kneeling_tone1":                             "🧎🏻\u200d♂️",
+	"man_kneeling_tone2":                             "🧎🏼\u200d♂️",
+	"man_kneeling_tone3":                             "🧎🏽\u200d♂️",
+	"man_kneeling_tone4":                             "🧎🏾\u200d♂️",
+	"man_kneeling_tone5":                             "🧎🏿\u200d♂️",
+	"man_lifting_weights":                            "🏋️\u200d♂️",
+	"man_lifting_weights_tone1":                      "🏋🏻\u200d♂️",
+	"man_lifting_weights_tone2":                      "🏋🏼\u200d♂️",
+	"man_lifting_weights_tone3":                      "🏋🏽\u200d♂️",
+	"man_lifting_weights_tone4":                      "🏋🏾\u200d♂️",
+	"man_lifting_weights_tone5":                      "🏋🏿\u200d♂️",
+	"man_mage":                                       "🧙\u200d♂️",
+	"man_mage_tone1":                                 "🧙🏻\u200d♂️",
+	"man_mage_tone2":                                 "🧙🏼\u200d♂️",
+	"man_mage_tone3":                                 "🧙🏽\u200d♂️",
+	"man_mage_tone4":                                 "🧙🏾\u200d♂️",
+	"man_mage_tone5":                                 "🧙🏿\u200d♂️",
+	"man_mechanic":                                   "👨\u200d🔧",
+	"man_mechanic_tone1":                             "👨🏻\u200d🔧",
+	"man_mechanic_tone2":                             "👨🏼\u200d🔧",
+	"man_mechanic_tone3":                             "👨🏽\u200d🔧",
+	"man_mechanic_tone4":                             "👨🏾\u200d🔧",
+	"man_mechanic_tone5":                             "👨🏿\u200d🔧",
+	"man_mountain_biking":                            "🚵\u200d♂️",
+	"man_mountain_biking_tone1":                      "🚵🏻\u200d♂️",
+	"man_mountain_biking_tone2":                      "🚵🏼\u200d♂️",
+	"man_mountain_biking_tone3":                      "🚵🏽\u200d♂️",
+	"man_mountain_biking_tone4":                      "🚵🏾\u200d♂️",
+	"man_mountain_biking_tone5":                      "🚵🏿\u200d♂️",
+	"man_office_worker":                              "👨\u200d💼",
+	"man_office_worker_tone1":                        "👨🏻\u200d💼",
+	"man_office_worker_tone2":                        "👨🏼\u200d💼",
+	"man_office_worker_tone3":                        "👨🏽\u200d💼",
+	"man_office_worker_tone4":                        "👨🏾\u200d💼",
+	"man_office_worker_tone5":                        "👨🏿\u200d💼",
+	"man_pilot":                                      "👨\u200d✈️",
+	"man_pilot_tone1":                                "👨🏻\u200d✈️",
+	"man_pilot_tone2":                                "👨🏼\u200d✈️",
+	"man_pilot_tone3":                                "👨🏽\u200d✈️",
+	"man_pilot_tone4":                                "👨🏾\u200d✈️",
+	"man_pilot_tone5":                                "👨🏿\u200d✈️",
+	"man_playing_handball":                           "🤾\u200d♂️",
+	"man_playing_handball_tone1":                     "🤾🏻\u200d♂️",
+	"man_playing_handball_tone2":                     "🤾🏼\u200d♂️",
+	"man_playing_handball_tone3":                     "🤾🏽\u200d♂️",
+	"man_playing_handball_tone4":                     "🤾🏾\u200d♂️",
+	"man_playing_handball_tone5":                     "🤾🏿\u200d♂️",
+	"man_playing_water_polo":                         "🤽\u200d♂️",
+	"man_playing_water_polo_tone1":                   "🤽🏻\u200d♂️",
+	"man_playing_water_polo_tone2":                   "🤽🏼\u200d♂️",
+	"man_playing_water_polo_tone3":                   "🤽🏽\u200d♂️",
+	"man_playing_water_polo_tone4":                   "🤽🏾\u200d♂️",
+	"man_playing_water_polo_tone5":                   "🤽🏿\u200d♂️",
+	"man_police_officer":                             "👮\u200d♂️",
+	"man_police_officer_tone1":                       "👮🏻\u200d♂️",
+	"man_police_officer_tone2":                       "👮🏼\u200d♂️",
+	"man_police_officer_tone3":                       "👮🏽\u200d♂️",
+	"man_police_officer_tone4":                       "👮🏾\u200d♂️",
+	"man_police_officer_tone5":                       "👮🏿\u200d♂️",
+	"man_pouting":                                    "🙎\u200d♂️",
+	"man_pouting_tone1":                              "🙎🏻\u200d♂️",
+	"man_pouting_tone2":                              "🙎🏼\u200d♂️",
+	"man_pouting_tone3":                              "🙎🏽\u200d♂️",
+	"man_pouting_tone4":                              "🙎🏾\u200d♂️",
+	"man_pouting_tone5":                              "🙎🏿\u200d♂️",
+	"man_raising_hand":                               "🙋\u200d♂️",
+	"man_raising_hand_tone1":                         "🙋🏻\u200d♂️",
+	"man_raising_hand_tone2":                         "🙋🏼\u200d♂️",
+	"man_raising_hand_tone3":                         "🙋🏽\u200d♂️",
+	"man_raising_hand_tone4":                         "🙋🏾\u200d♂️",
+	"man_raising_hand_tone5":                         "🙋🏿\u200d♂️",
+	"man_red_hair":                                   "👨\u200d🦰",
+	"man_rowing_boat":                                "🚣\u200d♂️",
+	"man_rowing_boat_tone1":                          "🚣🏻\u200d♂️",
+	"man_rowing_boat_tone2":                          "🚣🏼\u200d♂️",
+	"man_rowing_boat_tone3":                          "🚣🏽\u200d♂️",
+	"man_rowing_boat_tone4":                          "🚣🏾\u200d♂️",
+	"man_rowing_boat_tone5":                          "🚣🏿\u200d♂️",
+	"man_running":                                    "🏃\u200d♂️",
+	"man_running_facing_right":                       "🏃\u200d♂️\u200d➡️",
+	"man_running_facing_right_tone1":                 "🏃🏻\u200d♂️\u200d➡️",
+	"man_running_facing_right_tone2":                 "🏃🏼\u200d♂️\u200d➡️",
+	"man_running_facing_right_tone3":                 "🏃🏽\u200d♂️\u200d➡️",
+	"man_running_facing_right_tone4":                 "🏃🏾\u200d♂️\u200d➡️",
+	"man_running_facing_right_tone5":                 "🏃🏿\u200d♂️\u200d➡️",
+	"man_running_tone1":                              "🏃🏻\u200d♂️",
+	"man_running_tone2":                              "🏃🏼\u200d♂️",
+	"man_running_tone3":                              "🏃🏽\u200d♂️",
+	"man_running_tone4":                              "🏃🏾\u200d♂️",
+	"man_running_tone5":                              "🏃🏿\u200d♂️",
+	"man_scientist":                                  "👨\u200d🔬",
+	"man_scientist_tone1":                            "👨🏻\u200d🔬",
+	"man_scientist_tone2":                            "👨🏼\u200d🔬",
+	"man_scientist_tone3":                            "👨🏽\u200d🔬",
+	"man_scientist_tone4":                            "👨🏾\u200d🔬",
+	"man_scientist_tone5":                            "👨🏿\u200d🔬",
+	"man_shrugging":                                  "🤷\u200d♂️",
+	"man_shrugging_tone1":                            "🤷🏻\u200d♂️",
+	"man_shrugging_tone2":                            "🤷🏼\u200d♂️",
+	"man_shrugging_tone3":                            "🤷🏽\u200d♂️",
+	"man_shrugging_tone4":                            "🤷🏾\u200d♂️",
+	"man_shrugging_tone5":                            "🤷🏿\u200d♂️",
+	"man_singer":                                     "👨\u200d🎤",
+	"man_singer_tone1":                               "👨🏻\u200d🎤",
+	"man_singer_tone2":                               "👨🏼\u200d🎤",
+	"man_singer_tone3":                               "👨🏽\u200d🎤",
+	"man_singer_tone4":                               "👨🏾\u200d🎤",
+	"man_singer_tone5":                               "👨🏿\u200d🎤",
+	"man_standing":                                   "🧍\u200d♂️",
+	"man_standing_tone1":                             "🧍🏻\u200d♂️",
+	"man_standing_tone2":                             "🧍🏼\u200d♂️",
+	"man_standing_tone3":                             "🧍🏽\u200d♂️",
+	"man_standing_tone4":                             "🧍🏾\u200d♂️",
+	"man_standing_tone5":                             "🧍🏿\u200d♂️",
+	"man_student":                                    "👨\u200d🎓",
+	"man_student_tone1":                              "👨🏻\u200d🎓",
+	"man_student_tone2":                              "👨🏼\u200d🎓",
+	"man_student_tone3":                              "👨🏽\u200d🎓",
+	"man_student_tone4":                              "👨🏾\u200d🎓",
+	"man_student_tone5":                              "👨🏿\u200d🎓",
+	"man_superhero":                                  "🦸\u200d♂️",
+	"man_superhero_tone1":                            "🦸🏻\u200d♂️",
+	"man_superhero_tone2":                            "🦸🏼\u200d♂️",
+	"man_superhero_tone3":                            "🦸🏽\u200d♂️",
+	"man_superhero_tone4":                            "🦸🏾\u200d♂️",
+	"man_superhero_tone5":                            "🦸🏿\u200d♂️",
+	"man_supervillain":                               "🦹\u200d♂️",
+	"man_supervillain_tone1":                         "🦹🏻\u200d♂️",
+	"man_supervillain_tone2":                         "🦹🏼\u200d♂️",
+	"man_supervillain_tone3":                         "🦹🏽\u200d♂️",
+	"man_supervillain_tone4":                         "🦹🏾\u200d♂️",
+	"man_supervillain_tone5":                         "🦹🏿\u200d♂️",
+	"man_surfing":                                    "🏄\u200d♂️",
+	"man_surfing_tone1":                              "🏄🏻\u200d♂️",
+	"man_surfing_tone2":                              "🏄🏼\u200d♂️",
+	"man_surfing_tone3":                              "🏄🏽\u200d♂️",
+	"man_surfing_tone4":                              "🏄🏾\u200d♂️",
+	"man_surfing_tone5":                              "🏄🏿\u200d♂️",
+	"man_swimming":                                   "🏊\u200d♂️",
+	"man_swimming_tone1":                             "🏊🏻\u200d♂️",
+	"man_swimming_tone2":                             "🏊🏼\u200d♂️",
+	"man_swimming_tone3":                             "🏊🏽\u200d♂️",
+	"man_swimming_tone4":                             "🏊🏾\u200d♂️",
+	"man_swimming_tone5":                             "🏊🏿\u200d♂️",
+	"man_teacher":                                    "👨\u200d🏫",
+	"man_teacher_tone1":                              "👨🏻\u200d🏫",
+	"man_teacher_tone2":                              "👨🏼\u200d🏫",
+	"man_teacher_tone3":                              "👨🏽\u200d🏫",
+	"man_teacher_tone4":                              "👨🏾\u200d🏫",
+	"man_teacher_tone5":                              "👨🏿\u200d🏫",
+	"man_technologist":                               "👨\u200d💻",
+	"man_technologist_tone1":                         "👨🏻\u200d💻",
+	"man_technologist_tone2":                         "👨🏼\u200d💻",
+	"man_technologist_tone3":                         "👨🏽\u200d💻",
+	"man_technologist_tone4":                         "👨🏾\u200d💻",
+	"man_technologist_tone5":                         "👨🏿\u200d💻",
+	"man_tipping_hand":                               "💁\u200d♂️",
+	"man_tipping_hand_tone1":                         "💁🏻\u200d♂️",
+	"man_tipping_hand_tone2":                         "💁🏼\u200d♂️",
+	"man_tipping_hand_tone3":                         "💁🏽\u200d♂️",
+	"man_tipping_hand_tone4":                         "💁🏾\u200d♂️",
+	"man_tipping_hand_tone5":                         "💁🏿\u200d♂️",
+	"man_tone1":                                      "👨🏻",
+	"man_tone1_bald":                                 "👨🏻\u200d🦲",
+	"man_tone1_beard":                                "🧔🏻\u200d♂️",
+	"man_tone1_blond_hair":                           "👱🏻\u200d♂️",
+	"man_tone1_curly_hair":                           "👨🏻\u200d🦱",
+	"man_tone1_red_hair":                             "👨🏻\u200d🦰",
+	"man_tone1_white_hair":                           "👨🏻\u200d🦳",
+	"man_tone2":                                      "👨🏼",
+	"man_tone2_bald":                                 "👨🏼\u200d🦲",
+	"man_tone2_beard":                                "🧔🏼\u200d♂️",
+	"man_tone2_blond_hair":                           "👱🏼\u200d♂️",
+	"man_tone2_curly_hair":                           "👨🏼\u200d🦱",
+	"man_tone2_red_hair":                             "👨🏼\u200d🦰",
+	"man_tone2_white_hair":                           "👨🏼\u200d🦳",
+	"man_tone3":                                      "👨🏽",
+	"man_tone3_bald":                                 "👨🏽\u200d🦲",
+	"man_tone3_beard":                                "🧔🏽\u200d♂️",
+	"man_tone3_blond_hair":                           "👱🏽\u200d♂️",
+	"man_tone3_curly_hair":                           "👨🏽\u200d🦱",
+	"man_tone3_red_hair":                             "👨🏽\u200d🦰",
+	"man_tone3_white_hair":                           "👨🏽\u200d🦳",
+	"man_tone4":                                      "👨🏾",
+	"man_tone4_bald":                                 "👨🏾\u200d🦲",
+	"man_tone4_beard":                                "🧔🏾\u200d♂️",
+	"man_tone4_blond_hair":                           "👱🏾\u200d♂️",
+	"man_tone4_curly_hair":                           "👨🏾\u200d🦱",
+	"man_tone4_red_hair":                             "👨🏾\u200d🦰",
+	"man_tone4_white_hair":                           "👨🏾\u200d🦳",
+	"man_tone5":                                      "👨🏿",
+	"man_tone5_bald":                                 "👨🏿\u200d🦲",
+	"man_tone5_beard":                                "🧔🏿\u200d♂️",
+	"man_tone5_blond_hair":                           "👱🏿\u200d♂️",
+	"man_tone5_curly_hair":                           "👨🏿\u200d🦱",
+	"man_tone5_red_hair":                             "👨🏿\u200d🦰",
+	"man_tone5_white_hair":                           "👨🏿\u200d🦳",
+	"man_vampire":                                    "🧛\u200d♂️",
+	"man_vampire_tone1":                              "🧛🏻\u200d♂️",
+	"man_vampire_tone2":                              "🧛🏼\u200d♂️",
+	"man_vampire_tone3":                              "🧛🏽\u200d♂️",
+	"man_vampire_tone4":                              "🧛🏾\u200d♂️",
+	"man_vampire_tone5":                              "🧛🏿\u200d♂️",
+	"man_walking":                                    "🚶\u200d♂️",
+	"man_walking_facing_right":                       "🚶\u200d♂️\u200d➡️",
+	"man_walking_facing_right_tone1":                 "🚶🏻\u200d♂️\u200d➡️",
+	"man_walking_facing_right_tone2":                 "🚶🏼\u200d♂️\u200d➡️",
+	"man_walking_facing_right_tone3":                 "🚶🏽\u200d♂️\u200d➡️",
+	"man_walking_facing_right_tone4":                 "🚶🏾\u200d♂️\u200d➡️",
+	"man_walking_facing_right_tone5":                 "🚶🏿\u200d♂️\u200d➡️",
+	"man_walking_tone1":                              "🚶🏻\u200d♂️",
+	"man_walking_tone2":                              "🚶🏼\u200d♂️",
+	"man_walking_tone3":                              "🚶🏽\u200d♂️",
+	"man_walking_tone4":                              "🚶🏾\u200d♂️",
+	"man_walking_tone5":                              "🚶🏿\u200d♂️",
+	"man_wearing_turban":                             "👳\u200d♂️",
+	"man_wearing_turban_tone1":                       "👳🏻\u200d♂️",
+	"man_wearing_turban_tone2":                       "👳🏼\u200d♂️",
+	"man_wearing_turban_tone3":                       "👳🏽\u200d♂️",
+	"man_wearing_turban_tone4":                       "👳🏾\u200d♂️",
+	"man_wearing_turban_tone5":                       "👳🏿\u200d♂️",
+	"man_white_hair":                                 "👨\u200d🦳",
+	"man_with_veil":                                  "👰\u200d♂️",
+	"man_with_veil_tone1":                            "👰🏻\u200d♂️",
+	"man_with_veil_tone2":                            "👰🏼\u200d♂️",
+	"man_with_veil_tone3":                            "👰🏽\u200d♂️",
+	"man_with_veil_tone4":                            "👰🏾\u200d♂️",
+	"man_with_veil_tone5":                            "👰🏿\u200d♂️",
+	"man_with_white_cane":                            "👨\u200d🦯",
+	"man_with_white_cane_facing_right":               "👨\u200d🦯\u200d➡️",
+	"man_with_white_cane_facing_right_tone1":         "👨🏻\u200d🦯\u200d➡️",
+	"man_with_white_cane_facing_right_tone2":         "👨🏼\u200d🦯\u200d➡️",
+	"man_with_white_cane_facing_right_tone3":         "👨🏽\u200d🦯\u200d➡️",
+	"man_with_white_cane_facing_right_tone4":         "👨🏾\u200d🦯\u200d➡️",
+	"man_with_white_cane_facing_right_tone5":         "👨🏿\u200d🦯\u200d➡️",
+	"man_with_white_cane_tone1":                      "👨🏻\u200d🦯",
+	"man_with_white_cane_tone2":                      "👨🏼\u200d🦯",
+	"man_with_white_cane_tone3":                      "👨🏽\u200d🦯",
+	"man_with_white_cane_tone4":                      "👨🏾\u200d🦯",
+	"man_with_white_cane_tone5":                      "👨🏿\u200d🦯",
+	"man_zombie":                                     "🧟\u200d♂️",
+	"mango":                                          "🥭",
+	"mans_shoe":                                      "👞",
+	"mantelpiece_clock":                              "🕰️",
+	"manual_wheelchair":                              "🦽",
+	"map_of_japan":                                   "🗾",
+	"maple_leaf":                                     "🍁",
+	"maracas":                                        "🪇",
+	"martial_arts_uniform":                           "🥋",
+	"mate":                                           "🧉",
+	"meat_on_bone":                                   "🍖",
+	"mechanic":                                       "🧑\u200d🔧",
+	"mechanic_tone1":                                 "🧑🏻\u200d🔧",
+	"mechanic_tone2":                                 "🧑🏼\u200d🔧",
+	"mechanic_tone3":                                 "🧑🏽\u200d🔧",
+	"mechanic_tone4":                                 "🧑🏾\u200d🔧",
+	"mechanic_tone5":                                 "🧑🏿\u200d🔧",
+	"mechanical_arm":                                 "🦾",
+	"mechanical_leg":                                 "🦿",
+	"medical_symbol":                                 "⚕️",
+	"megaphone":                                      "📣",
+	"melon":                                          "🍈",
+	"melting_face":                                   "🫠",
+	"memo":                                           "📝",
+	"men_holding_hands":                              "👬",
+	"men_holding_hands_tone1":                        "👬🏻",
+	"men_holding_hands_tone1_tone2":                  "👨🏻\u200d🤝\u200d👨🏼",
+	"men_holding_hands_tone1_tone3":                  "👨🏻\u200d🤝\u200d👨🏽",
+	"men_holding_hands_tone1_tone4":                  "👨🏻\u200d🤝\u200d👨🏾",
+	"men_holding_hands_tone1_tone5":                  "👨🏻\u200d🤝\u200d👨🏿",
+	"men_holding_hands_tone2":                        "👬🏼",
+	"men_holding_hands_tone2_tone1":                  "👨🏼\u200d🤝\u200d👨🏻",
+	"men_holding_hands_tone2_tone3":                  "👨🏼\u200d🤝\u200d👨🏽",
+	"men_holding_hands_tone2_tone4":                  "👨🏼\u200d🤝\u200d👨🏾",
+	"men_holding_hands_tone2_tone5":                  "👨🏼\u200d🤝\u200d👨🏿",
+	"men_holding_hands_tone3":                        "👬🏽",
+	"men_holding_hands_tone3_tone1":                  "👨🏽\u200d🤝\u200d👨🏻",
+	"men_holding_hands_tone3_tone2":                  "👨🏽\u200d🤝\u200d👨🏼",
+	"men_holding_hands_tone3_tone4":                  "👨🏽\u200d🤝\u200d👨🏾",
+	"men_holding_hands_tone3_tone5":                  "👨🏽\u200d🤝\u200d👨🏿",
+	"men_holding_hands_tone4":                        "👬🏾",
+	"men_holding_hands_tone4_tone1":                  "👨🏾\u200d🤝\u200d👨🏻",
+	"men_holding_hands_tone4_tone2":                  "👨🏾\u200d🤝\u200d👨🏼",
+	"men_holding_hands_tone4_tone3":                  "👨🏾\u200d🤝\u200d👨🏽",
+	"men_holding_hands_tone4_tone5":                  "👨🏾\u200d🤝\u200d👨🏿",
+	"men_holding_hands_tone5":                        "👬🏿",
+	"men_holding_hands_tone5_tone1":                  "👨🏿\u200d🤝\u200d👨🏻",
+	"men_holding_hands_tone5_tone2":                  "👨🏿\u200d🤝\u200d👨🏼",
+	"men_holding_hands_tone5_tone3":                  "👨🏿\u200d🤝\u200d👨🏽",
+	"men_holding_hands_tone5_tone4":                  "👨🏿\u200d🤝\u200d👨🏾",
+	"men_with_bunny_ears":                            "👯\u200d♂️",
+	"men_wrestling":                                  "🤼\u200d♂️",
+	"mending_heart":                                  "❤️\u200d🩹",
+	"menorah":                                        "🕎",
+	"mens_room":                                      "🚹",
+	"mermaid":                                        "🧜\u200d♀️",
+	"mermaid_tone1":                                  "🧜🏻\u200d♀️",
+	"mermaid_tone2":                                  "🧜🏼\u200d♀️",
+	"mermaid_tone3":                                  "🧜🏽\u200d♀️",
+	"mermaid_tone4":                                  "🧜🏾\u200d♀️",
+	"mermaid_tone5":                                  "🧜🏿\u200d♀️",
+	"merman":                                         "🧜\u200d♂️",
+	"merman_tone1":                                   "🧜🏻\u200d♂️",
+	"merman_tone2":                                   "🧜🏼\u200d♂️",
+	"merman_tone3":                                   "🧜🏽\u200d♂️",
+	"merman_tone4":                                   "🧜🏾\u200d♂️",
+	"merman_tone5":                                   "🧜🏿\u200d♂️",
+	"merperson":                                      "🧜",
+	"merperson_tone1":                                "🧜🏻",
+	"merperson_tone2":                                "🧜🏼",
+	"merperson_tone3":                                "🧜🏽",
+	"merperson_tone4":                                "🧜🏾",
+	"merperson_tone5":                                "🧜🏿",
+	"metro":                                          "🚇",
+	"microbe":                                        "🦠",
+	"microphone":                                     "🎤",
+	"microscope":                                     "🔬",
+	"middle_finger":                                  "🖕",
+	"middle_finger_tone1":                            "🖕🏻",
+	"middle_finger_tone2":                            "🖕🏼",
+	"middle_finger_tone3":                            "🖕🏽",
+	"middle_finger_tone4":                            "🖕🏾",
+	"middle_finger_tone5":                            "🖕🏿",
+	"military_helmet":                                "🪖",
+	"military_medal":                                 "🎖️",
+	"milky_way":                                      "🌌",
+	"minibus":                                        "🚐",
+	"minus":                                          "➖",
+	"mirror":                                         "🪞",
+	"mirror_ball":                                    "🪩",
+	"moai":                                           "🗿",
+	"mobile_phone":                                   "📱",
+	"mobile_phone_off":                               "📴",
+	"mobile_phone_with_arrow":                        "📲",
+	"money_bag":                                      "💰",
+	"money_mouth_face":                               "🤑",
+	"money_with_wings":                               "💸",
+	"monkey":                                         "🐒",
+	"monkey_face":                                    "🐵",
+	"monorail":                                       "🚝",
+	"moon_cake":                                      "🥮",
+	"moon_viewing_ceremony":                          "🎑",
+	"moose":                                          "🫎",
+	"mosque":                                         "🕌",
+	"mosquito":                                       "🦟",
+	"motor_boat":                                     "🛥️",
+	"motor_scooter":                                  "🛵",
+	"motorcycle":                                     "🏍️",
+	"motorized_wheelchair":                           "🦼",
+	"motorway":                                       "🛣️",
+	"mount_fuji":                                     "🗻",
+	"mountain":                                       "⛰️",
+	"mountain_cableway":                              "🚠",
+	"mountain_railway":                               "🚞",
+	"mouse":                                          "🐁",
+	"mouse_face":                                     "🐭",
+	"mouse_trap":                                     "🪤",
+	"mouth":                                          "👄",
+	"movie_camera":                                   "🎥",
+	"mrs_claus":                                      "🤶",
+	"mrs_claus_tone1":                                "🤶🏻",
+	"mrs_claus_tone2":                                "🤶🏼",
+	"mrs_claus_tone3":                                "🤶🏽",
+	"mrs_claus_tone4":                                "🤶🏾",
+	"mrs_claus_tone5":                                "🤶🏿",
+	"multiply":                                       "✖️",
+	"mushroom":                                       "🍄",
+	"musical_keyboard":                               "🎹",
+	"musical_note":                                   "🎵",
+	"musical_notes":                                  "🎶",
+	"musical_score":                                  "🎼",
+	"muted_speaker":                                  "🔇",
+	"mx_claus":                                       "🧑\u200d🎄",
+	"mx_claus_tone1":                                 "🧑🏻\u200d🎄",
+	"mx_claus_tone2":                                 "🧑🏼\u200d🎄",
+	"mx_claus_tone3":                                 "🧑🏽\u200d🎄",
+	"mx_claus_tone4":                                 "🧑🏾\u200d🎄",
+	"mx_claus_tone5":                                 "🧑🏿\u200d🎄",
+	"nail_polish":                                    "💅",
+	"nail_polish_tone1":                              "💅🏻",
+	"nail_polish_tone2":                              "💅🏼",
+	"nail_polish_tone3":                              "💅🏽",
+	"nail_polish_tone4":                              "💅🏾",
+	"nail_polish_tone5":                              "💅🏿",
+	"name_badge":                                     "📛",
+	"national_park":                                  "🏞️",
+	"nauseated_face":                                 "🤢",
+	"nazar_amulet":                                   "🧿",
+	"necktie":                                        "👔",
+	"nerd_face":                                      "🤓",
+	"nest_with_eggs":                                 "🪺",
+	"nesting_dolls":                                  "🪆",
+	"neutral_face":                                   "😐",
+	"new_button":                                     "🆕",
+	"new_moon":                                       "🌑",
+	"new_moon_face":                                  "🌚",
+	"newspaper":                                      "📰",
+	"next_track_button":                              "⏭️",
+	"ng_button":                                      "🆖",
+	"night_with_stars":                               "🌃",
+	"nine":                                           "9️⃣",
+	"nine_oclock":                                    "🕘",
+	"nine_thirty":                                    "🕤",
+	"ninja":                                          "🥷",
+	"ninja_tone1":                                    "🥷🏻",
+	"ninja_tone2":                                    "🥷🏼",
+	"ninja_tone3":                                    "🥷🏽",
+	"ninja_tone4":                                    "🥷🏾",
+	"ninja_tone5":                                    "🥷🏿",
+	"no_bicycles":                                    "🚳",
+	"no_entry":                                       "⛔",
+	"no_littering":                                   "🚯",
+	"no_mobile_phones":                               "📵",
+	"no_one_under_eighteen":                          "🔞",
+	"no_pedestrians":                                 "🚷",
+	"no_smoking":                                     "🚭",
+	"non_potable_water":                              "🚱",
+	"nose":                                           "👃",
+	"nose_tone1":                                     "👃🏻",
+	"nose_tone2":                                     "👃🏼",
+	"nose_tone3":                                     "👃🏽",
+	"nose_tone4":                                     "👃🏾",
+	"nose_tone5":                                     "👃🏿",
+	"notebook":                                       "📓",
+	"notebook_with_decorative_cover":                 "📔",
+	"nut_and_bolt":                                   "🔩",
+	"o_button_blood_type":                            "🅾️",
+	"octopus":                                        "🐙",
+	"oden":                                           "🍢",
+	"office_building":                                "🏢",
+	"office_worker":                                  "🧑\u200d💼",
+	"office_worker_tone1":                            "🧑🏻\u200d💼",
+	"office_worker_tone2":                            "🧑🏼\u200d💼",
+	"office_worker_tone3":                            "🧑🏽\u200d💼",
+	"office_worker_tone4":                            "🧑🏾\u200d💼",
+	"office_worker_tone5":                            "🧑🏿\u200d💼",
+	"ogre":                                           "👹",
+	"oil_drum":                                       "🛢️",
+	"ok_button":                                      "🆗",
+	"ok_hand":                                        "👌",
+	"ok_hand_tone1":                                  "👌🏻",
+	"ok_hand_tone2":                                  "👌🏼",
+	"ok_hand_tone3":                                  "👌🏽",
+	"ok_hand_tone4":                                  "👌🏾",
+	"ok_hand_tone5":                                  "👌🏿",
+	"old_key":                                        "🗝️",
+	"old_man":                                        "👴",
+	"old_man_tone1":                                  "👴🏻",
+	"old_man_tone2":                                  "👴🏼",
+	"old_man_tone3":                                  "👴🏽",
+	"old_man_tone4":                                  "👴🏾",
+	"old_man_tone5":                                  "👴🏿",
+	"old_woman":                                      "👵",
+	"old_woman_tone1":                                "👵🏻",
+	"old_woman_tone2":                                "👵🏼",
+	"old_woman_tone3":                                "👵🏽",
+	"old_woman_tone4":                                "👵🏾",
+	"old_woman_tone5":                                "👵🏿",
+	"older_person":                                   "🧓",
+	"older_person_tone1":                             "🧓🏻",
+	"older_person_tone2":                             "🧓🏼",
+	"older_person_tone3":                             "🧓🏽",
+	"older_person_tone4":                             "🧓🏾",
+	"older_person_tone5":                             "🧓🏿",
+	"olive":                                          "🫒",
+	"om":                                             "🕉️",
+	"on_arrow":                                       "🔛",
+	"oncoming_automobile":                            "🚘",
+	"oncoming_bus":                                   "🚍",
+	"oncoming_fist":                                  "👊",
+	"oncoming_fist_tone1":                            "👊🏻",
+	"oncoming_fist_tone2":                            "👊🏼",
+	"oncoming_fist_tone3":                            "👊🏽",
+	"oncoming_fist_tone4":                            "👊🏾",
+	"oncoming_fist_tone5":                            "👊🏿",
+	"oncoming_police_car":                            "🚔",
+	"oncoming_taxi":                                  "🚖",
+	"one":                                            "1️⃣",
+	"one_oclock":                                     "🕐",
+	"one_piece_swimsuit":                             "🩱",
+	"one_thirty":                                     "🕜",
+	"onion":                                          "🧅",
+	"open_book":                                      "📖",
+	"open_file_folder":                               "📂",
+	"open_hands":                                     "👐",
+	"open_hands_tone1":                               "👐🏻",
+	"open_hands_tone2":                               "👐🏼",
+	"open_hands_tone3":                               "👐🏽",
+	"open_hands_tone4":                               "👐🏾",
+	"open_hands_tone5":                               "👐🏿",
+	"open_mailbox_with_lowered_flag":                 "📭",
+	"open_mailbox_with_raised_flag":                  "📬",
+	"ophiuchus":                                      "⛎",
+	"optical_disk":                                   "💿",
+	"orange_book":                                    "📙",
+	"orange_circle":                                  "🟠",
+	"orange_heart":                                   "🧡",
+	"orange_square":                                  "🟧",
+	"orangutan":                                      "🦧",
+	"orthodox_cross":                                 "☦️",
+	"otter":                                          "🦦",
+	"outbox_tray":                                    "📤",
+	"owl":                                            "🦉",
+	"ox":                                             "🐂",
+	"oyster":                                         "🦪",
+	"p_button":                                       "🅿️",
+	"package":                                        "📦",
+	"page_facing_up":                                 "📄",
+	"page_with_curl":                                 "📃",
+	"pager":                                          "📟",
+	"paintbrush":                                     "🖌️",
+	"palm_down_hand":                                 "🫳",
+	"palm_down_hand_tone1":                           "🫳🏻",
+	"palm_down_hand_tone2":                           "🫳🏼",
+	"palm_down_hand_tone3":                           "🫳🏽",
+	"palm_down_hand_tone4":                           "🫳🏾",
+	"palm_down_hand_tone5":                           "🫳🏿",
+	"palm_tree":                                      "🌴",
+	"palm_up_hand":                                   "🫴",
+	"palm_up_hand_tone1":                             "🫴🏻",
+	"palm_up_hand_tone2":                             "🫴🏼",
+	"palm_up_hand_tone3":                             "🫴🏽",
+	"palm_up_hand_tone4":                             "🫴🏾",
+	"palm_up_hand_tone5":                             "🫴🏿",
+	"palms_up_together":                              "🤲",
+	"palms_up_together_tone1":                        "🤲🏻",
+	"palms_up_together_tone2":                        "🤲🏼",
+	"palms_up_together_tone3":                        "🤲🏽",
+	"palms_up_together_tone4":                        "🤲🏾",
+	"palms_up_together_tone5":                        "🤲🏿",
+	"pancakes":                                       "🥞",
+	"panda":                                          "🐼",
+	"paperclip":                                      "📎",
+	"parachute":                                      "🪂",
+	"parrot":                                         "🦜",
+	"part_alternation_mark":                          "〽️",
+	"party_popper":                                   "🎉",
+	"partying_face":                                  "🥳",
+	"passenger_ship":                                 "🛳️",
+	"passport_control":                               "🛂",
+	"pause_button":                                   "⏸️",
+	"paw_prints":                                     "🐾",
+	"pea_pod":                                        "🫛",
+	"peace_symbol":                                   "☮️",
+	"peach":                                          "🍑",
+	"peacock":                                        "🦚",
+	"peanuts":                                        "🥜",
+	"pear":                                           "🍐",
+	"pen":                                            "🖊️",
+	"pencil":                                         "✏️",
+	"penguin":                                        "🐧",
+	"pensive_face":                                   "😔",
+	"people_holding_hands":                           "🧑\u200d🤝\u200d🧑",
+	"people_holding_hands_tone1":                     "🧑🏻\u200d🤝\u200d🧑🏻",
+	"people_holding_hands_tone1_tone2":               "🧑🏻\u200d🤝\u200d🧑🏼",
+	"people_holding_hands_tone1_tone3":               "🧑🏻\u200d🤝\u200d🧑🏽",
+	"people_holding_hands_tone1_tone4":               "🧑🏻\u200d🤝\u200d🧑🏾",
+	"people_holding_hands_tone1_tone5":               "🧑🏻\u200d🤝\u200d🧑🏿",
+	"people_holding_hands_tone2":                     "🧑🏼\u200d🤝\u200d🧑🏼",
+	"people_holding_hands_tone2_tone1":               "🧑🏼\u200d🤝\u200d🧑🏻",
+	"people_holding_hands_tone2_tone3":               "🧑🏼\u200d🤝\u200d🧑🏽",
+	"people_holding_hands_tone2_tone4":               "🧑🏼\u200d🤝\u200d🧑🏾",
+	"people_holding_hands_tone2_tone5":               "🧑🏼\u200d🤝\u200d🧑🏿",
+	"people_holding_hands_tone3":                     "🧑🏽\u200d🤝\u200d🧑🏽",
+	"people_holding_hands_tone3_tone1":               "🧑🏽\u200d🤝\u200d🧑🏻",
+	"people_holding_hands_tone3_tone2":               "🧑🏽\u200d🤝\u200d🧑🏼",
+	"people_holding_hands_tone3_tone4":               "🧑🏽\u200d🤝\u200d🧑🏾",
+	"people_holding_hands_tone3_tone5":               "🧑🏽\u200d🤝\u200d🧑🏿",
+	"people_holding_hands_tone4":                     "🧑🏾\u200d🤝\u200d🧑🏾",
+	"people_holding_hands_tone4_tone1":               "🧑🏾\u200d🤝\u200d🧑🏻",
+	"people_holding_hands_tone4_tone2":               "🧑🏾\u200d🤝\u200d🧑🏼",
+	"people_holding_hands_tone4_tone3":               "🧑🏾\u200d🤝\u200d🧑🏽",
+	"people_holding_hands_tone4_tone5":               "🧑🏾\u200d🤝\u200d🧑🏿",
+	"people_holding_hands_tone5":                     "🧑🏿\u200d🤝\u200d🧑🏿",
+	"people_holding_hands_tone5_tone1":               "🧑🏿\u200d🤝\u200d🧑🏻",
+	"people_holding_hands_tone5_tone2":               "🧑🏿\u200d🤝\u200d🧑🏼",
+	"people_holding_hands_tone5_tone3":               "🧑🏿\u200d🤝\u200d🧑🏽",
+	"people_holding_hands_tone5_tone4":               "🧑🏿\u200d🤝\u200d🧑🏾",
+	"people_hugging":                                 "🫂",
+	"people_with_bunny_ears":                         "👯",
+	"people_wrestling":                               "🤼",
+	"performing_arts":                                "🎭",
+	"persevering_face":                               "😣",
+	"person":                                         "🧑",
+	"person_bald":                                    "🧑\u200d🦲",
+	"person_beard":                                   "🧔",
+	"person_biking":                                  "🚴",
+	"person_biking_tone1":                            "🚴🏻",
+	"person_biking_tone2":                            "🚴🏼",
+	"person_biking_tone3":                            "🚴🏽",
+	"person_biking_tone4":                            "🚴🏾",
+	"person_biking_tone5":                            "🚴🏿",
+	"person_blond_hair":                              "👱",
+	"person_bouncing_ball":                           "⛹️",
+	"person_bouncing_ball_tone1":                     "⛹🏻",
+	"person_bouncing_ball_tone2":                     "⛹🏼",
+	"person_bouncing_ball_tone3":                     "⛹🏽",
+	"person_bouncing_ball_tone4":                     "⛹🏾",
+	"person_bouncing_ball_tone5":                     "⛹🏿",
+	"person_bowing":                                  "🙇",
+	"person_bowing_tone1":                            "🙇🏻",
+	"person_bowing_tone2":                            "🙇🏼",
+	"person_bowing_tone3":                            "🙇🏽",
+	"person_bowing_tone4":                            "🙇🏾",
+	"person_bowing_tone5":                            "🙇🏿",
+	"person_cartwheeling":                            "🤸",
+	"person_cartwheeling_tone1":                      "🤸🏻",
+	"person_cartwheeling_tone2":                      "🤸🏼",
+	"person_cartwheeling_tone3":                      "🤸🏽",
+	"person_cartwheeling_tone4":                      "🤸🏾",
+	"person_cartwheeling_tone5":                      "🤸🏿",
+	"person_climbing":                                "🧗",
+	"person_climbing_tone1":                          "🧗🏻",
+	"person_climbing_tone2":                          "🧗🏼",
+	"person_climbing_tone3":                          "🧗🏽",
+	"person_climbing_tone4":                          "🧗🏾",
+	"person_climbing_tone5":                          "🧗🏿",
+	"person_curly_hair":                              "🧑\u200d🦱",
+	"person_facepalming":                             "🤦",
+	"person_facepalming_tone1":                       "🤦🏻",
+	"person_facepalming_tone2":                       "🤦🏼",
+	"person_facepalming_tone3":                       "🤦🏽",
+	"person_facepalming_tone4":                       "🤦🏾",
+	"person_facepalming_tone5":                       "🤦🏿",
+	"person_feeding_baby":                            "🧑\u200d🍼",
+	"person_feeding_baby_tone1":                      "🧑🏻\u200d🍼",
+	"person_feeding_baby_tone2":                      "🧑🏼\u200d🍼",
+	"person_feeding_baby_tone3":                      "🧑🏽\u200d🍼",
+	"person_feeding_baby_tone4":                      "🧑🏾\u200d🍼",
+	"person_feeding_baby_tone5":                      "🧑🏿\u200d🍼",
+	"person_fencing":                                 "🤺",
+	"person_frowning":                                "🙍",
+	"person_frowning_tone1":                          "🙍🏻",
+	"person_frowning_tone2":                          "🙍🏼",
+	"person_frowning_tone3":                          "🙍🏽",
+	"person_frowning_tone4":                          "🙍🏾",
+	"person_frowning_tone5":                          "🙍🏿",
+	"person_gesturing_no":                            "🙅",
+	"person_gesturing_no_tone1":                      "🙅🏻",
+	"person_gesturing_no_tone2":                      "🙅🏼",
+	"person_gesturing_no_tone3":                      "🙅🏽",
+	"person_gesturing_no_tone4":                      "🙅🏾",
+	"person_gesturing_no_tone5":                      "🙅🏿",
+	"person_gesturing_ok":                            "🙆",
+	"person_gesturing_ok_tone1":                      "🙆🏻",
+	"person_gesturing_ok_tone2":                      "🙆🏼",
+	"person_gesturing_ok_tone3":                      "🙆🏽",
+	"person_gesturing_ok_tone4":                      "🙆🏾",
+	"person_gesturing_ok_tone5":                      "🙆🏿",
+	"person_getting_haircut":                         "💇",
+	"person_getting_haircut_tone1":                   "💇🏻",
+	"person_getting_haircut_tone2":                   "💇🏼",
+	"person_getting_haircut_tone3":                   "💇🏽",
+	"person_getting_haircut_tone4":                   "💇🏾",
+	"person_getting_haircut_tone5":                   "💇🏿",
+	"person_getting_massage":                         "💆",
+	"person_getting_massage_tone1":                   "💆🏻",
+	"person_getting_massage_tone2":                   "💆🏼",
+	"person_getting_massage_tone3":                   "💆🏽",
+	"person_getting_massage_tone4":                   "💆🏾",
+	"person_getting_massage_tone5":                   "💆🏿",
+	"person_golfing":                                 "🏌️",
+	"person_golfing_tone1":                           "🏌🏻",
+	"person_golfing_tone2":                           "🏌🏼",
+	"person_golfing_tone3":                           "🏌🏽",
+	"person_golfing_tone4":                           "🏌🏾",
+	"person_golfing_tone5":                           "🏌🏿",
+	"person_in_bed":                                  "🛌",
+	"person_in_bed_tone1":                            "🛌🏻",
+	"person_in_bed_tone2":                            "🛌🏼",
+	"person_in_bed_tone3":                            "🛌🏽",
+	"person_in_bed_tone4":                            "🛌🏾",
+	"person_in_bed_tone5":                            "🛌🏿",
+	"person_in_lotus_position":                       "🧘",
+	"person_in_lotus_position_tone1":                 "🧘🏻",
+	"person_in_lotus_position_tone2":                 "🧘🏼",
+	"person_in_lotus_position_tone3":                 "🧘🏽",
+	"person_in_lotus_position_tone4":                 "🧘🏾",
+	"person_in_lotus_position_tone5":                 "🧘🏿",
+	"person_in_manual_wheelchair":                    "🧑\u200d🦽",
+	"person_in_manual_wheelchair_facing_right":       "🧑\u200d🦽\u200d➡️",
+	"person_in_manual_wheelchair_facing_right_tone1":    "🧑🏻\u200d🦽\u200d➡️",
+	"person_in_manual_wheelchair_facing_right_tone2":    "🧑🏼\u200d🦽\u200d➡️",
+	"person_in_manual_wheelchair_facing_right_tone3":    "🧑🏽\u200d🦽\u200d➡️",
+	"person_in_manual_wheelchair_facing_right_tone4":    "🧑🏾\u200d🦽\u200d➡️",
+	"person_in_manual_wheelchair_facing_right_tone5":    "🧑🏿\u200d🦽\u200d➡️",
+	"person_in_manual_wheelchair_tone1":                 "🧑🏻\u200d🦽",
+	"person_in_manual_wheelchair_tone2":                 "🧑🏼\u200d🦽",
+	"person_in_manual_wheelchair_tone3":                 "🧑🏽\u200d🦽",
+	"person_in_manual_wheelchair_tone4":                 "🧑🏾\u200d🦽",
+	"person_in_manual_wheelchair_tone5":                 "🧑🏿\u200d🦽",
+	"person_in_motorized_wheelchair":                    "🧑\u200d🦼",
+	"person_in_motorized_wheelchair_facing_right":       "🧑\u200d🦼\u200d➡️",
+	"person_in_motorized_wheelchair_facing_right_tone1": "🧑🏻\u200d🦼\u200d➡️",
+	"person_in_motorized_wheelchair_facing_right_tone2": "🧑🏼\u200d🦼\u200d➡️",
+	"person_in_motorized_wheelchair_facing_right_tone3": "🧑🏽\u200d🦼\u200d➡️",
+	"person_in_motorized_wheelchair_facing_right_tone4": "🧑🏾\u200d🦼\u200d➡️",
+	"person_in_motorized_wheelchair_facing_right_tone5": "🧑🏿\u200d🦼\u200d➡️",
+	"person_in_motorized_wheelchair_tone1":              "🧑🏻\u200d🦼",
+	"person_in_motorized_wheelchair_tone2":              "🧑🏼\u200d🦼",
+	"person_in_motorized_wheelchair_tone3":              "🧑🏽\u200d🦼",
+	"person_in_motorized_wheelchair_tone4":              "🧑🏾\u200d🦼",
+	"person_in_motorized_wheelchair_tone5":              "🧑🏿\u200d🦼",
+	"person_in_steamy_room":                             "🧖",
+	"person_in_steamy_room_tone1":                       "🧖🏻",
+	"person_in_steamy_room_tone2":                       "🧖🏼",
+	"person_in_steamy_room_tone3":                       "🧖🏽",
+	"person_in_steamy_room_tone4":                       "🧖🏾",
+	"person_in_steamy_room_tone5":                       "🧖🏿",
+	"person_in_suit_levitating":                         "🕴️",
+	"person_in_suit_levitating_tone1":                   "🕴🏻",
+	"person_in_suit_levitating_tone2":                   "🕴🏼",
+	"person_in_suit_levitating_tone3":                   "🕴🏽",
+	"person_in_suit_levitating_tone4":                   "🕴🏾",
+	"person_in_suit_levitating_tone5":                   "🕴🏿",
+	"person_in_tuxedo":                                  "🤵",
+	"person_in_tuxedo_tone1":                            "🤵🏻",
+	"person_in_tuxedo_tone2":                            "🤵🏼",
+	"person_in_tuxedo_tone3":                            "🤵🏽",
+	"person_in_tuxedo_tone4":                            "🤵🏾",
+	"person_in_tuxedo_tone5":                            "🤵🏿",
+	"person_juggling":                                   "🤹",
+	"person_juggling_tone1":                             "🤹🏻",
+	"person_juggling_tone2":                             "🤹🏼",
+	"person_juggling_tone3":                             "🤹🏽",
+	"person_juggling_tone4":                             "🤹🏾",
+	"person_juggling_tone5":                             "🤹🏿",
+	"person_kneeling":                                   "🧎",
+	"person_kneeling_facing_right":                      "🧎\u200d➡️",
+	"person_kneeling_facing_right_tone1":                "🧎🏻\u200d➡️",
+	"person_kneeling_facing_right_tone2":                "🧎🏼\u200d➡️",
+	"person_kneeling_facing_right_tone3":                "🧎🏽\u200d➡️",
+	"person_kneeling_facing_right_tone4":                "🧎🏾\u200d➡️",
+	"person_kneeling_facing_right_tone5":                "🧎🏿\u200d➡️",
+	"person_kneeling_tone1":                             "🧎🏻",
+	"person_kneeling_tone2":                             "🧎🏼",
+	"person_kneeling_tone3":                             "🧎🏽",
+	"person_kneeling_tone4":                             "🧎🏾",
+	"person_kneeling_tone5":                             "🧎🏿",
+	"person_lifting_weights":                            "🏋️",
+	"person_lifting_weights_tone1":                      "🏋🏻",
+	"person_lifting_weights_tone2":                      "🏋🏼",
+	"person_lifting_weights_tone3":                      "🏋🏽",
+	"person_lifting_weights_tone4":                      "🏋🏾",
+	"person_lifting_weights_tone5":                      "🏋🏿",
+	"person_mountain_biking":                            "🚵",
+	"person_mountain_biking_tone1":                      "🚵🏻",
+	"person_mountain_biking_tone2":                      "🚵🏼",
+	"person_mountain_biking_tone3":                      "🚵🏽",
+	"person_mountain_biking_tone4":                      "🚵🏾",
+	"person_mountain_biking_tone5":                      "🚵🏿",
+	"person_playing_handball":                           "🤾",
+	"person_playing_handball_tone1":                     "🤾🏻",
+	"person_playing_handball_tone2":                     "🤾🏼",
+	"person_playing_handball_tone3":                     "🤾🏽",
+	"person_playing_handball_tone4":                     "🤾🏾",
+	"person_playing_handball_tone5":                     "🤾🏿",
+	"person_playing_water_polo":                         "🤽",
+	"person_playing_water_polo_tone1":                   "🤽🏻",
+	"person_playing_water_polo_tone2":                   "🤽🏼",
+	"person_playing_water_polo_tone3":                   "🤽🏽",
+	"person_playing_water_polo_tone4":                   "🤽🏾",
+	"person_playing_water_polo_tone5":                   "🤽🏿",
+	"person_pouting":                                    "🙎",
+	"person_pouting_tone1":                              "🙎🏻",
+	"person_pouting_tone2":                              "🙎🏼",
+	"person_pouting_tone3":                              "🙎🏽",
+	"person_pouting_tone4":                              "🙎🏾",
+	"person_pouting_tone5":                              "🙎🏿",
+	"person_raising_hand":                               "🙋",
+	"person_raising_hand_tone1":                         "🙋🏻",
+	"person_raising_hand_tone2":                         "🙋🏼",
+	"person_raising_hand_tone3":                         "🙋🏽",
+	"person_raising_hand_tone4":                         "🙋🏾",
+	"person_raising_hand_tone5":                         "🙋🏿",
+	"person_red_hair":                                   "🧑\u200d🦰",
+	"person_rowing_boat":                                "🚣",
+	"person_rowing_boat_tone1":                          "🚣🏻",
+	"person_rowing_boat_tone2":                          "🚣🏼",
+	"person_rowing_boat_tone3":                          "🚣🏽",
+	"person_rowing_boat_tone4":                          "🚣🏾",
+	"person_rowing_boat_tone5":                          "🚣🏿",
+	"person_running":                                    "🏃",
+	"person_running_facing_right":                       "🏃\u200d➡️",
+	"person_running_facing_right_tone1":                 "🏃🏻\u200d➡️",
+	"person_running_facing_right_tone2":                 "🏃🏼\u200d➡️",
+	"person_running_facing_right_tone3":                 "🏃🏽\u200d➡️",
+	"person_running_facing_right_tone4":                 "🏃🏾\u200d➡️",
+	"person_running_facing_right_tone5":                 "🏃🏿\u200d➡️",
+	"person_running_tone1":                              "🏃🏻",
+	"person_running_tone2":                              "🏃🏼",
+	"person_running_tone3":                              "🏃🏽",
+	"person_running_tone4":                              "🏃🏾",
+	"person_running_tone5":                              "🏃🏿",
+	"person_shrugging":                                  "🤷",
+	"person_shrugging_tone1":                            "🤷🏻",
+	"person_shrugging_tone2":                            "🤷🏼",
+	"person_shrugging_tone3":                            "🤷🏽",
+	"person_shrugging_tone4":                            "🤷🏾",
+	"person_shrugging_tone5":                            "🤷🏿",
+	"person_standing":                                   "🧍",
+	"person_standing_tone1":                             "🧍🏻",
+	"person_standing_tone2":                             "🧍🏼",
+	"person_standing_tone3":                             "🧍🏽",
+	"person_standing_tone4":                             "🧍🏾",
+	"person_standing_tone5":                             "🧍🏿",
+	"person_surfing":                                    "🏄",
+	"person_surfing_tone1":                              "🏄🏻",
+	"person_surfing_tone2":                              "🏄🏼",
+	"person_surfing_tone3":                              "🏄🏽",
+	"person_surfing_tone4":                              "🏄🏾",
+	"person_surfing_tone5":                              "🏄🏿",
+	"person_swimming":                                   "🏊",
+	"person_swimming_tone1":                             "🏊🏻",
+	"person_swimming_tone2":                             "🏊🏼",
+	"person_swimming_tone3":                             "🏊🏽",
+	"person_swimming_tone4":                             "🏊🏾",
+	"person_swimming_tone5":                             "🏊🏿",
+	"person_taking_bath":                                "🛀",
+	"person_taking_bath_tone1":                          "🛀🏻",
+	"person_taking_bath_tone2":                          "🛀🏼",
+	"person_taking_bath_tone3":                          "🛀🏽",
+	"person_taking_bath_tone4":                          "🛀🏾",
+	"person_taking_bath_tone5":                          "🛀🏿",
+	"person_tipping_hand":                               "💁",
+	"person_tipping_hand_tone1":                         "💁🏻",
+	"person_tipping_hand_tone2":                         "💁🏼",
+	"person_tipping_hand_tone3":                         "💁🏽",
+	"person_tipping_hand_tone4":                         "💁🏾",
+	"person_tipping_hand_tone5":                         "💁🏿",
+	"person_tone1":                                      "🧑🏻",
+	"person_tone1_bald":                                 "🧑🏻\u200d🦲",
+	"person_tone1_beard":                                "🧔🏻",
+	"person_tone1_blond_hair":                           "👱🏻",
+	"person_tone1_curly_hair":                           "🧑🏻\u200d🦱",
+	"person_tone1_red_hair":                             "🧑🏻\u200d🦰",
+	"person_tone1_white_hair":                           "🧑🏻\u200d🦳",
+	"person_tone2":                                      "🧑🏼",
+	"person_tone2_bald":                                 "🧑🏼\u200d🦲",
+	"person_tone2_beard":                                "🧔🏼",
+	"person_tone2_blond_hair":                           "👱🏼",
+	"person_tone2_curly_hair":                           "🧑🏼\u200d🦱",
+	"person_tone2_red_hair":                             "🧑🏼\u200d🦰",
+	"person_tone2_white_hair":                           "🧑🏼\u200d🦳",
+	"person_tone3":                                      "🧑🏽",
+	"person_tone3_bald":                                 "🧑🏽\u200d🦲",
+	"person_tone3_beard":                                "🧔🏽",
+	"person_tone3_blond_hair":                           "👱🏽",
+	"person_tone3_curly_hair":                           "🧑🏽\u200d🦱",
+	"person_tone3_red_hair":                             "🧑🏽\u200d🦰",
+	"person_tone3_white_hair":                           "🧑🏽\u200d🦳",
+	"person_tone4":                                      "🧑🏾",
+	"person_tone4_bald":                                 "🧑🏾\u200d🦲",
+	"person_tone4_beard":                                "🧔🏾",
+	"person_tone4_blond_hair":                           "👱🏾",
+	"person_tone4_curly_hair":                           "🧑🏾\u200d🦱",
+	"person_tone4_red_hair":                             "🧑🏾\u200d🦰",
+	"person_tone4_white_hair":                           "🧑🏾\u200d🦳",
+	"person_tone5":                                      "🧑🏿",
+	"person_tone5_bald":                                 "🧑🏿\u200d🦲",
+	"person_tone5_beard":                                "🧔🏿",
+	"person_tone5_blond_hair":                           "👱🏿",
+	"person_tone5_curly_hair":                           "🧑🏿\u200d🦱",
+	"person_tone5_red_hair":                             "🧑🏿\u200d🦰",
+	"person_tone5_white_hair":                           "🧑🏿\u200d🦳",
+	"person_walking":                                    "🚶",
+	"person_walking_facing_right":                       "🚶\u200d➡️",
+	"person_walking_facing_right_tone1":                 "🚶🏻\u200d➡️",
+	"person_walking_facing_right_tone2":                 "🚶🏼\u200d➡️",
+	"person_walking_facing_right_tone3":                 "🚶🏽\u200d➡️",
+	"person_walking_facing_right_tone4":                 "🚶🏾\u200d➡️",
+	"person_walking_facing_right_tone5":                 "🚶🏿\u200d➡️",
+	"person_walking_tone1":                              "🚶🏻",
+	"person_walking_tone2":                              "🚶🏼",
+	"person_walking_tone3":                              "🚶🏽",
+	"person_walking_tone4":                              "🚶🏾",
+	"person_walking_tone5":                              "🚶🏿",
+	"person_wearing_turban":                             "👳",
+	"person_wearing_turban_tone1":                       "👳🏻",
+	"person_wearing_turban_tone2":                       "👳🏼",
+	"person_wearing_turban_tone3":                       "👳🏽",
+	"person_wearing_turban_tone4":                       "👳🏾",
+	"person_wearing_turban_tone5":                       "👳🏿",
+	"person_white_hair":                                 "🧑\u200d🦳",
+	"person_with_crown":                                 "🫅",
+	"person_with_crown_tone1":                           "🫅🏻",
+	"person_with_crown_tone2":                           "🫅🏼",
+	"person_with_crown_tone3":                           "🫅🏽",
+	"person_with_crown_tone4":                           "🫅🏾",
+	"person_with_crown_tone5":                           "🫅🏿",
+	"person_with_skullcap":                              "👲",
+	"person_with_skullcap_tone1":                        "👲🏻",
+	"person_with_skullcap_tone2":                        "👲🏼",
+	"person_with_skullcap_tone3":                        "👲🏽",
+	"person_with_skullcap_tone4":                        "👲🏾",
+	"person_with_skullcap_tone5":                        "👲🏿",
+	"person_with_veil":                                  "👰",
+	"person_with_veil_tone1":                            "👰🏻",
+	"person_with_veil_tone2":                            "👰🏼",
+	"person_with_veil_tone3":                            "👰🏽",
+	"person_with_veil_tone4":                            "👰🏾",
+	"person_with_veil_tone5":                            "👰🏿",
+	"person_with_white_cane":                            "🧑\u200d🦯",
+	"person_with_white_cane_facing_right":               "🧑\u200d🦯\u200d➡️",
+	"person_with_white_cane_facing_right_tone1":         "🧑🏻\u200d🦯\u200d➡️",
+	"person_with_white_cane_facing_right_tone2":         "🧑🏼\u200d🦯\u200d➡️",
+	"person_with_white_cane_facing_right_tone3":         "🧑🏽\u200d🦯\u200d➡️",
+	"person_with_white_cane_facing_right_tone4":         "🧑🏾\u200d🦯\u200d➡️",
+	"person_with_white_cane_facing_right_tone5":         "🧑🏿\u200d🦯\u200d➡️",
+	"person_with_white_cane_tone1":                      "🧑🏻\u200d🦯",
+	"person_with_white_cane_tone2":                      "🧑🏼\u200d🦯",
+	"person_with_white_cane_tone3":                      "🧑🏽\u200d🦯",
+	"person_with_white_cane_tone4":                      "🧑🏾\u200d🦯",
+	"person_with_white_cane_tone5":                      "🧑🏿\u200d🦯",
+	"petri_dish":                                        "🧫",
+	"phoenix":                                           "🐦\u200d🔥",
+	"pick":                                              "⛏️",
+	"pickup_truck":                                      "🛻",
+	"pie":                                               "🥧",
+	"pig":                                               "🐖",
+	"pig_face":                                          "🐷",
+	"pig_nose":                                          "🐽",
+	"pile_of_poo":                                       "💩",
+	"pill":                                              "💊",
+	"pilot":                                             "🧑\u200d✈️",
+	"pilot_tone1":                                       "🧑🏻\u200d✈️",
+	"pilot_tone2":                                       "🧑🏼\u200d✈️",
+	"pilot_tone3":                                       "🧑🏽\u200d✈️",
+	"pilot_tone4":                                       "🧑🏾\u200d✈️",
+	"pilot_tone5":                                       "🧑🏿\u200d✈️",
+	"pinata":                                            "🪅",
+	"pinched_fingers":                                   "🤌",
+	"pinched_fingers_tone1":                             "🤌🏻",
+	"pinched_fingers_tone2":                             "🤌🏼",
+	"pinched_fingers_tone3":                             "🤌🏽",
+	"pinched_fingers_tone4":                             "🤌🏾",
+	"pinched_fingers_tone5":                             "🤌🏿",
+	"pinching_hand":                                     "🤏",
+	"pinching_hand_tone1":                               "🤏🏻",
+	"pinching_hand_tone2":                               "🤏🏼",
+	"pinching_hand_tone3":                               "🤏🏽",
+	"pinching_hand_tone4":                               "🤏🏾",
+	"pinching_hand_tone5":                               "🤏🏿",
+	"pine_decoration":                                   "🎍",
+	"pineapple":                                         "🍍",
+	"ping_pong":                                         "🏓",
+	"pink_heart":                                        "🩷",
+	"pirate_flag":                                       "🏴\u200d☠️",
+	"pisces":                                            "♓",
+	"pizza":                                             "🍕",
+	"placard":                                           "🪧",
+	"place_of_worship":                                  "🛐",
+	"play_button":                                       "▶️",
+	"play_or_pause_button":                              "⏯️",
+	"playground_slide":                                  "🛝",
+	"pleading_face":                                     "🥺",
+	"plunger":                                           "🪠",
+	"plus":                                              "➕",
+	"polar_bear":                                        "🐻\u200d❄️",
+	"police_car":                                        "🚓",
+	"police_car_light":                                  "🚨",
+	"police_officer":                                    "👮",
+	"police_officer_tone1":                              "👮🏻",
+	"police_officer_tone2":                              "👮🏼",
+	"police_officer_tone3":                              "👮🏽",
+	"police_officer_tone4":                              "👮🏾",
+	"police_officer_tone5":                              "👮🏿",
+	"poodle":                                            "🐩",
+	"pool_8_ball":                                       "🎱",
+	"popcorn":                                           "🍿",
+	"post_office":                                       "🏤",
+	"postal_horn":                                       "📯",
+	"postbox":                                           "📮",
+	"pot_of_food":                                       "🍲",
+	"potable_water":                                     "🚰",
+	"potato":                                            "🥔",
+	"potted_plant":                                      "🪴",
+	"poultry_leg":                                       "🍗",
+	"pound_banknote":                                    "💷",
+	"pouring_liquid":                                    "🫗",
+	"pouting_cat":                                       "😾",
+	"prayer_beads":                                      "📿",
+	"pregnant_man":                                      "🫃",
+	"pregnant_man_tone1":                                "🫃🏻",
+	"pregnant_man_tone2":                                "🫃🏼",
+	"pregnant_man_tone3":                                "🫃🏽",
+	"pregnant_man_tone4":                                "🫃🏾",
+	"pregnant_man_tone5":                                "🫃🏿",
+	"pregnant_person":                                   "🫄",
+	"pregnant_person_tone1":                             "🫄🏻",
+	"pregnant_person_tone2":                             "🫄🏼",
+	"pregnant_person_tone3":                             "🫄🏽",
+	"pregnant_person_tone4":                             "🫄🏾",
+	"pregnant_person_tone5":                             "🫄🏿",
+	"pregnant_woman":                                    "🤰",
+	"pregnant_woman_tone1":                              "🤰🏻",
+	"pregnant_woman_tone2":                              "🤰🏼",
+	"pregnant_woman_tone3":                              "🤰🏽",
+	"pregnant_woman_tone4":                              "🤰🏾",
+	"pregnant_woman_tone5":                              "🤰🏿",
+	"pretzel":                                           "🥨",
+	"prince":                                            "🤴",
+	"prince_tone1":                                      "🤴🏻",
+	"prince_tone2":                                      "🤴🏼",
+	"prince_tone3":                                      "🤴🏽",
+	"prince_tone4":                                      "🤴🏾",
+	"prince_tone5":                                      "🤴🏿",
+	"princess":                                          "👸",
+	"princess_tone1":                                    "👸🏻",
+	"princess_tone2":                                    "👸🏼",
+	"princess_tone3":                                    "👸🏽",
+	"princess_tone4":                                    "👸🏾",
+	"princess_tone5":                                    "👸🏿",
+	"printer":                                           "🖨️",
+	"prohibited":                                        "🚫",
+	"purple_circle":                                     "🟣",
+	"purple_heart":                                      "💜",
+	"purple_square":                                     "🟪",
+	"purse":                                             "👛",
+	"pushpin":                                           "📌",
+	"puzzle_piece":                                      "🧩",
+	"rabbit":                                            "🐇",
+	"rabbit_face":                                       "🐰",
+	"raccoon":                                           "🦝",
+	"racing_car":                                        "🏎️",
+	"radio":                                             "📻",
+	"radio_button":                                      "🔘",
+	"radioactive":                                       "☢️",
+	"railway_car":                                       "🚃",
+	"railway_track":                                     "🛤️",
+	"rainbow":                                           "🌈",
+	"rainbow_flag":                                      "🏳️\u200d🌈",
+	"raised_back_of_hand":                               "🤚",
+	"raised_back_of_hand_tone1":                         "🤚🏻",
+	"raised_back_of_hand_tone2":                         "🤚🏼",
+	"raised_back_of_hand_tone3":                         "🤚🏽",
+	"raised_back_of_hand_tone4":                         "🤚🏾",
+	"raised_back_of_hand_tone5":                         "🤚🏿",
+	"raised_fist":                                       "✊",
+	"raised_fist_tone1":                                 "✊🏻",
+	"raised_fist_tone2":                                 "✊🏼",
+	"raised_fist_tone3":                                 "✊🏽",
+	"raised_fist_tone4":                                 "✊🏾",
+	"raised_fist_tone5":                                 "✊🏿",
+	"raised_hand":                                       "✋",
+	"raised_hand_tone1":                                 "✋🏻",
+	"raised_hand_tone2":                                 "✋🏼",
+	"raised_hand_tone3":                                 "✋🏽",
+	"raised_hand_tone4":                                 "✋🏾",
+	"raised_hand_tone5":                                 "✋🏿",
+	"raising_hands":                                     "🙌",
+	"raising_hands_tone1":                               "🙌🏻",
+	"raising_hands_tone2":                               "🙌🏼",
+	"raising_hands_tone3":                               "🙌🏽",
+	"raising_hands_tone4":                               "🙌🏾",
+	"raising_hands_tone5":                               "🙌🏿",
+	"ram":                                               "🐏",
+	"rat":                                               "🐀",
+	"razor":                                             "🪒",
+	"receipt":                                           "🧾",
+	"record_button":                                     "⏺️",
+	"recycling_symbol":                                  "♻️",
+	"red_apple":                                         "🍎",
+	"red_circle":                                        "🔴",
+	"red_envelope":                                      "🧧",
+	"red_exclamation_mark":                              "❗",
+	"red_heart":                                         "❤️",
+	"red_paper_lantern":                                 "🏮",
+	"red_question_mark":                                 "❓",
+	"red_square":                                        "🟥",
+	"red_triangle_pointed_down":                         "🔻",
+	"red_triangle_pointed_up":                           "🔺",
+	"registered":                                        "®️",
+	"relieved_face":                                     "😌",
+	"reminder_ribbon":                                   "🎗️",
+	"repeat_button":                                     "🔁",
+	"repeat_single_button":                              "🔂",
+	"rescue_workers_helmet":                             "⛑️",
+	"restroom":                                          "🚻",
+	"reverse_button":                                    "◀️",
+	"revolving_hearts":                                  "💞",
+	"rhinoceros":                                        "🦏",
+	"ribbon":                                            "🎀",
+	"rice_ball":                                         "🍙",
+	"rice_cracker":                                      "🍘",
+	"right_anger_bubble":                                "🗯️",
+	"right_arrow":                                       "➡️",
+	"right_arrow_curving_down":                          "⤵️",
+	"right_arrow_curving_left":                          "↩️",
+	"right_arrow_curving_up":                            "⤴️",
+	"right_facing_fist":                                 "🤜",
+	"right_facing_fist_tone1":                           "🤜🏻",
+	"right_facing_fist_tone2":                           "🤜🏼",
+	"right_facing_fist_tone3":                           "🤜🏽",
+	"right_facing_fist_tone4":                           "🤜🏾",
+	"right_facing_fist_tone5":                           "🤜🏿",
+	"rightwards_hand":                                   "🫱",
+	"rightwards_hand_tone1":                             "🫱🏻",
+	"rightwards_hand_tone2":                             "🫱🏼",
+	"rightwards_hand_tone3":                             "🫱🏽",
+	"rightwards_hand_tone4":                             "🫱🏾",
+	"rightwards_hand_tone5":                             "🫱🏿",
+	"rightwards_pushing_hand":                           "🫸",
+	"rightwards_pushing_hand_tone1":                     "🫸🏻",
+	"rightwards_pushing_hand_tone2":                     "🫸🏼",
+	"rightwards_pushing_hand_tone3":                     "🫸🏽",
+	"rightwards_pushing_hand_tone4":                     "🫸🏾",
+	"rightwards_pushing_hand_tone5":                     "🫸🏿",
+	"ring":                                              "💍",
+	"ring_buoy":                                         "🛟",
+	"ringed_planet":                                     "🪐",
+	"roasted_sweet_potato":                              "🍠",
+	"robot":                                             "🤖",
+	"rock":                                              "🪨",
+	"rocket":                                            "🚀",
+	"roll_of_paper":                                     "🧻",
+	"rolled_up_newspaper":                               "🗞️",
+	"roller_coaster":                                    "🎢",
+	"roller_skate":                                      "🛼",
+	"rolling_on_the_floor_laughing":                     "🤣",
+	"rooster":                                           "🐓",
+	"rose":                                              "🌹",
+	"rosette":                                           "🏵️",
+	"round_pushpin":                                     "📍",
+	"rugby_football":                                    "🏉",
+	"running_shirt":                                     "🎽",
+	"running_shoe":                                      "👟",
+	"sad_but_relieved_face":                             "😥",
+	"safety_pin":                                        "🧷",
+	"safety_vest":                                       "🦺",
+	"sagittarius":                                       "♐",
+	"sailboat":                                          "⛵",
+	"sake":                                              "🍶",
+	"salt":                                              "🧂",
+	"saluting_face":                                     "🫡",
+	"sandwich":                                          "🥪",
+	"santa_claus":                                       "🎅",
+	"santa_claus_tone1":                                 "🎅🏻",
+	"santa_claus_tone2":                                 "🎅🏼",
+	"santa_claus_tone3":                                 "🎅🏽",
+	"santa_claus_tone4":                                 "🎅🏾",
+	"santa_claus_tone5":                                 "🎅🏿",
+	"sari":                                              "🥻",
+	"satellite":                                         "🛰️",
+	"satellite_antenna":                                 "📡",
+	"sauropod":                                          "🦕",
+	"saxophone":                                         "🎷",
+	"scarf":                                             "🧣",
+	"school":                                            "🏫",
+	"scientist":                                         "🧑\u200d🔬",
+	"scientist_tone1":                                   "🧑🏻\u200d🔬",
+	"scientist_tone2":                                   "🧑🏼\u200d🔬",
+	"scientist_tone3":                                   "🧑🏽\u200d🔬",
+	"scientist_tone4":                                   "🧑🏾\u200d🔬",
+	"scientist_tone5":                                   "🧑🏿\u200d🔬",
+	"scissors":                                          "✂️",
+	"scorpio":                                           "♏",
+	"scorpion":                                          "🦂",
+	"screwdriver":                                       "🪛",
+	"scroll":                                            "📜",
+	"seal":                                              "🦭",
+	"seat":                                              "💺",
+	"see_no_evil_monkey":                                "🙈",
+	"seedling":                                          "🌱",
+	"selfie":                                            "🤳",
+	"selfie_tone1":                                      "🤳🏻",
+	"selfie_tone2":                                      "🤳🏼",
+	"selfie_tone3":                                      "🤳🏽",
+	"selfie_tone4":                                      "🤳🏾",
+	"selfie_tone5":                                      "🤳🏿",
+	"service_dog":                                       "🐕\u200d🦺",
+	"seven":                                             "7️⃣",
+	"seven_oclock":                                      "🕖",
+	"seven_thirty":                                      "🕢",
+	"sewing_needle":                                     "🪡",
+	"shaking_face":                                      "🫨",
+	"shallow_pan_of_food":                               "🥘",
+	"shamrock":                                          "☘️",
+	"shark":                                             "🦈",
+	"shaved_ice":                                        "🍧",
+	"sheaf_of_rice":                                     "🌾",
+	"shield":                                            "🛡️",
+	"shinto_shrine":                                     "⛩️",
+	"ship":                                              "🚢",
+	"shooting_star":                                     "🌠",
+	"shopping_bags":                                     "🛍️",
+	"shopping_cart":                                     "🛒",
+	"shortcake":                                         "🍰",
+	"shorts":                                            "🩳",
+	"shower":                                            "🚿",
+	"shrimp":                                            "🦐",
+	"shuffle_tracks_button":                             "🔀",
+	"shushing_face":                                     "🤫",
+	"sign_of_the_horns":                                 "🤘",
+	"sign_of_the_horns_tone1":                           "🤘🏻",
+	"sign_of_the_horns_tone2":                           "🤘🏼",
+	"sign_of_the_horns_tone3":                           "🤘🏽",
+	"sign_of_the_horns_tone4":                           "🤘🏾",
+	"sign_of_the_horns_tone5":                           "🤘🏿",
+	"singer":                                            "🧑\u200d🎤",
+	"singer_tone1":                                      "🧑🏻\u200d🎤",
+	"singer_tone2":                                      "🧑🏼\u200d🎤",
+	"singer_tone3":                                      "🧑🏽\u200d🎤",
+	"singer_tone4":                                      "🧑🏾\u200d🎤",
+	"singer_tone5":                                      "🧑🏿\u200d🎤",
+	"six":                                               "6️⃣",
+	"six_oclock":                                        "🕕",
+	"six_thirty":                                        "🕡",
+	"skateboard":                                        "🛹",
+	"skier":                                             "⛷️",
+	"skis":                                              "🎿",
+	"skull":                                             "💀",
+	"skull_and_crossbones":                              "☠️",
+	"skunk":                                             "🦨",
+	"sled":                                              "🛷",
+	"sleeping_face":                                     "😴",
+	"sleepy_face":                                       "😪",
+	"slightly_frowning_face":                            "🙁",
+	"slightly_smiling_face":                             "🙂",
+	"slot_machine":                                      "🎰",
+	"sloth":                                             "🦥",
+	"small_airplane":                                    "🛩️",
+	"small_blue_diamond":                                "🔹",
+	"small_orange_diamond":                              "🔸",
+	"smiling_cat_with_heart_eyes":                       "😻",
+	"smiling_face":                                      "☺️",
+	"smiling_face_with_halo":                            "😇",
+	"smiling_face_with_heart_eyes":                      "😍",
+	"smiling_face_with_hearts":                          "🥰",
+	"smiling_face_with_horns":                           "😈",
+	"smiling_face_with_open_hands":                      "🤗",
+	"smiling_face_with_smiling_eyes":                    "😊",
+	"smiling_face_with_sunglasses":                      "😎",
+	"smiling_face_with_tear":                            "🥲",
+	"smirking_face":                                     "😏",
+	"snail":                                             "🐌",
+	"snake":                                             "🐍",
+	"sneezing_face":                                     "🤧",
+	"snow_capped_mountain":                              "🏔️",
+	"snowboarder":                                       "🏂",
+	"snowboarder_tone1":                                 "🏂🏻",
+	"snowboarder_tone2":                                 "🏂🏼",
+	"snowboarder_tone3":                                 "🏂🏽",
+	"snowboarder_tone4":                                 "🏂🏾",
+	"snowboarder_tone5":                                 "🏂🏿",
+	"snowflake":                                         "❄️",
+	"snowman":                                           "☃️",
+	"snowman_without_snow":                              "⛄",
+	"soap":                                              "🧼",
+	"soccer_ball":                                       "⚽",
+	"socks":                                             "🧦",
+	"soft_ice_cream":                                    "🍦",
+	"softball":                                          "🥎",
+	"soon_arrow":                                        "🔜",
+	"sos_button":                                        "🆘",
+	"spade_suit":                                        "♠️",
+	"spaghetti":                                         "🍝",
+	"sparkle":                                           "❇️",
+	"sparkler":                                          "🎇",
+	"sparkles":                                          "✨",
+	"sparkling_heart":                                   "💖",
+	"speak_no_evil_monkey":                              "🙊",
+	"speaker_high_volume":                               "🔊",
+	"speaker_low_volume":                                "🔈",
+	"speaker_medium_volume":                             "🔉",
+	"speaking_head":                                     "🗣️",
+	"speech_balloon":                                    "💬",
+	"speedboat":                                         "🚤",
+	"spider":                                            "🕷️",
+	"spider_web":                                        "🕸️",
+	"spiral_calendar":                                   "🗓️",
+	"spiral_notepad":                                    "🗒️",
+	"spiral_shell":                                      "🐚",
+	"sponge":                                            "🧽",
+	"spoon":                                             "🥄",
+	"sport_utility_vehicle":                             "🚙",
+	"sports_medal":                                      "🏅",
+	"spouting_whale":                                    "🐳",
+	"squid":                                             "🦑",
+	"squinting_face_with_tongue":                        "😝",
+	"stadium":                                           "🏟️",
+	"star":                                              "⭐",
+	"star_and_crescent":                                 "☪️",
+	"star_of_david":                                     "✡️",
+	"star_struck":                                       "🤩",
+	"station":                                           "🚉",
+	"statue_of_liberty":                                 "🗽",
+	"steaming_bowl":                                     "🍜",
+	"stethoscope":                                       "🩺",
+	"stop_button":                                       "⏹️",
+	"stop_sign":                                         "🛑",
+	"stopwatch":                                         "⏱️",
+	"straight_ruler":                                    "📏",
+	"strawberry":                                        "🍓",
+	"student":                                           "🧑\u200d🎓",
+	"student_tone1":                                     "🧑🏻\u200d🎓",
+	"student_tone2":                                     "🧑🏼\u200d🎓",
+	"student_tone3":                                     "🧑🏽\u200d🎓",
+	"student_tone4":                                     "🧑🏾\u200d🎓",
+	"student_tone5":                                     "🧑🏿\u200d🎓",
+	"studio_microphone":                                 "🎙️",
+	"stuffed_flatbread":                                 "🥙",
+	"sun":                                               "☀️",
+	"sun_behind_cloud":                                  "⛅",
+	"sun_behind_large_cloud":                            "🌥️",
+	"sun_behind_rain_cloud":                             "🌦️",
+	"sun_behind_small_cloud":                            "🌤️",
+	"sun_with_face":                                     "🌞",
+	"sunflower":                                         "🌻",
+	"sunglasses":                                        "🕶️",
+	"sunrise":                                           "🌅",
+	"sunrise_over_mountains":                            "🌄",
+	"sunset":                                            "🌇",
+	"superhero":                                         "🦸",
+	"superhero_tone1":                                   "🦸🏻",
+	"superhero_tone2":                                   "🦸🏼",
+	"superhero_tone3":                                   "🦸🏽",
+	"superhero_tone4":                                   "🦸🏾",
+	"superhero_tone5":                                   "🦸🏿",
+	"supervillain":                                      "🦹",
+	"supervillain_tone1":                                "🦹🏻",
+	"supervillain_tone2":                                "🦹🏼",
+	"supervillain_tone3":                                "🦹🏽",
+	"supervillain_tone4":                                "🦹🏾",
+	"supervillain_tone5":                                "🦹🏿",
+	"sushi":                                             "🍣",
+	"suspension_railway":                                "🚟",
+	"swan":                                              "🦢",
+	"sweat_droplets":                                    "💦",
+	"synagogue":                                         "🕍",
+	"syringe":                                           "💉",
+	"t_rex":                                             "🦖",
+	"t_shirt":                                           "👕",
+	"taco":                                              "🌮",
+	"takeout_box":                                       "🥡",
+	"tamale":                                            "🫔",
+	"tanabata_tree":                                     "🎋",
+	"tangerine":                                         "🍊",
+	"taurus":                                            "♉",
+	"taxi":                                              "🚕",
+	"teacher":                                           "🧑\u200d🏫",
+	"teacher_tone1":                                     "🧑🏻\u200d🏫",
+	"teacher_tone2":                                     "🧑🏼\u200d🏫",
+	"teacher_tone3":                                     "🧑🏽\u200d🏫",
+	"teacher_tone4":                                     "🧑🏾\u200d🏫",
+	"teacher_tone5":                                     "🧑🏿\u200d🏫",
+	"teacup_without_handle":                             "🍵",
+	"teapot":                                            "🫖",
+	"tear_off_calendar":                                 "📆",
+	"technologist":                                      "🧑\u200d💻",
+	"technologist_tone1":                                "🧑🏻\u200d💻",
+	"technologist_tone2":                                "🧑🏼\u200d💻",
+	"technologist_tone3":                                "🧑🏽\u200d💻",
+	"technologist_tone4":                                "🧑🏾\u200d💻",
+	"technologist_tone5":                                "🧑🏿\u200d💻",
+	"teddy_bear":                                        "🧸",
+	"telephone":                                         "☎️",
+	"telephone_receiver":                                "📞",
+	"telescope":                                         "🔭",
+	"television":                                        "📺",
+	"ten_oclock":                                        "🕙",
+	"ten_thirty":                                        "🕥",
+	"tennis":                                            "🎾",
+	"tent":                                              "⛺",
+	"test_tube":                                         "🧪",
+	"thermometer":                                       "🌡️",
+	"thinking_face":                                     "🤔",
+	"thong_sandal":                                      "🩴",
+	"thought_balloon":                                   "💭",
+	"thread":                                            "🧵",
+	"three":                                             "3️⃣",
+	"three_oclock":                                      "🕒",
+	"three_thirty":                                      "🕞",
+	"thumbs_down":                                       "👎",
+	"thumbs_down_tone1":                                 "👎🏻",
+	"thumbs_down_tone2":                                 "👎🏼",
+	"thumbs_down_tone3":                                 "👎🏽",
+	"thumbs_down_tone4":                                 "👎🏾",
+	"thumbs_down_tone5":                                 "👎🏿",
+	"thumbs_up":                                         "👍",
+	"thumbs_up_tone1":                                   "👍🏻",
+	"thumbs_up_tone2":                                   "👍🏼",
+	"thumbs_up_tone3":                                   "👍🏽",
+	"thumbs_up_tone4":                                   "👍🏾",
+	"thumbs_up_tone5":                                   "👍🏿",
+	"ticket":                                            "🎫",
+	"tiger":                                             "🐅",
+	"tiger_face":                                        "🐯",
+	"timer_clock":                                       "⏲️",
+	"tired_face":                                        "😫",
+	"toilet":                                            "🚽",
+	"tokyo_tower":                                       "🗼",
+	"tomato":                                            "🍅",
+	"tongue":                                            "👅",
+	"toolbox":                                           "🧰",
+	"tooth":                                             "🦷",
+	"toothbrush":                                        "🪥",
+	"top_arrow":                                         "🔝",
+	"top_hat":                                           "🎩",
+	"tornado":                                           "🌪️",
+	"trackball":                                         "🖲️",
+	"tractor":                                           "🚜",
+	"trade_mark":                                        "™️",
+	"train":                                             "🚆",
+	"tram":                                              "🚊",
+	"tram_car":                                          "🚋",
+	"transgender_flag":                                  "🏳️\u200d⚧️",
+	"transgender_symbol":                                "⚧️",
+	"triangular_flag":                                   "🚩",
+	"triangular_ruler":                                  "📐",
+	"trident_emblem":                                    "🔱",
+	"troll":                                             "🧌",
+	"trolleybus":                                        "🚎",
+	"trophy":                                            "🏆",
+	"tropical_drink":                                    "🍹",
+	"tropical_fish":                                     "🐠",
+	"trumpet":                                           "🎺",
+	"tulip":                                             "🌷",
+	"tumbler_glass":                                     "🥃",
+	"turkey":                                            "🦃",
+	"turtle":                                            "🐢",
+	"twelve_oclock":                                     "🕛",
+	"twelve_thirty":                                     "🕧",
+	"two":                                               "2️⃣",
+	"two_hearts":                                        "💕",
+	"two_hump_camel":                                    "🐫",
+	"two_oclock":                                        "🕑",
+	"two_thirty":                                        "🕝",
+	"umbrella":                                          "☂️",
+	"umbrella_on_ground":                                "⛱️",
+	"umbrella_with_rain_drops":                          "☔",
+	"unamused_face":                                     "😒",
+	"unicorn":                                           "🦄",
+	"unlocked":                                          "🔓",
+	"up_arrow":                                          "⬆️",
+	"up_button":                                         "🆙",
+	"up_down_arrow":                                     "↕️",
+	"up_left_arrow":                                     "↖️",
+	"up_right_arrow":                                    "↗️",
+	"upside_down_face":                                  "🙃",
+	"upwards_button":                                    "🔼",
+	"vampire":                                           "🧛",
+	"vampire_tone1":                                     "🧛🏻",
+	"vampire_tone2":                                     "🧛🏼",
+	"vampire_tone3":                                     "🧛🏽",
+	"vampire_tone4":                                     "🧛🏾",
+	"vampire_tone5":                                     "🧛🏿",
+	"vertical_traffic_light":                            "🚦",
+	"vibration_mode":                                    "📳",
+	"victory_hand":                                      "✌️",
+	"victory_hand_tone1":                                "✌🏻",
+	"victory_hand_tone2":                                "✌🏼",
+	"victory_hand_tone3":                                "✌🏽",
+	"victory_hand_tone4":                                "✌🏾",
+	"victory_hand_tone5":                                "✌🏿",
+	"video_camera":                                      "📹",
+	"video_game":                                        "🎮",
+	"videocassette":                                     "📼",
+	"violin":                                            "🎻",
+	"virgo":                                             "♍",
+	"volcano":                                           "🌋",
+	"volleyball":                                        "🏐",
+	"vs_button":                                         "🆚",
+	"vulcan_salute":                                     "🖖",
+	"vulcan_salute_tone1":                               "🖖🏻",
+	"vulcan_salute_tone2":                               "🖖🏼",
+	"vulcan_salute_tone3":                               "🖖🏽",
+	"vulcan_salute_tone4":                               "🖖🏾",
+	"vulcan_salute_tone5":                               "🖖🏿",
+	"waffle":                                            "🧇",
+	"waning_crescent_moon":                              "🌘",
+	"waning_gibbous_moon":                               "🌖",
+	"warning":                                           "⚠️",
+	"wastebasket":                                       "🗑️",
+	"watch":                                             "⌚",
+	"water_buffalo":                                     "🐃",
+	"water_closet":                                      "🚾",
+	"water_pistol":                                      "🔫",
+	"water_wave":                                        "🌊",
+	"watermelon":                                        "🍉",
+	"waving_hand":                                       "👋",
+	"waving_hand_tone1":                                 "👋🏻",
+	"waving_hand_tone2":                                 "👋🏼",
+	"waving_hand_tone3":                                 "👋🏽",
+	"waving_hand_tone4":                                 "👋🏾",
+	"waving_hand_tone5":                                 "👋🏿",
+	"wavy_dash":                                         "〰️",
+	"waxing_crescent_moon":                              "🌒",
+	"waxing_gibbous_moon":                               "🌔",
+	"weary_cat":                                         "🙀",
+	"weary_face":                                        "😩",
+	"wedding":                                           "💒",
+	"whale":                                             "🐋",
+	"wheel":                                             "🛞",
+	"wheel_of_dharma":                                   "☸️",
+	"wheelchair_symbol":                                 "♿",
+	"white_cane":                                        "🦯",
+	"white_circle":                                      "⚪",
+	"white_exclamation_mark":                            "❕",
+	"white_flag":                                        "🏳️",
+	"white_flower":                                      "💮",
+	"white_heart":                                       "🤍",
+	"white_large_square":                                "⬜",
+	"white_medium_small_square":                         "◽",
+	"white_medium_square":                               "◻️",
+	"white_question_mark":                               "❔",
+	"white_small_square":                                "▫️",
+	"white_square_button":                               "🔳",
+	"wilted_flower":                                     "🥀",
+	"wind_chime":                                        "🎐",
+	"wind_face":                                         "🌬️",
+	"window":                                            "🪟",
+	"wine_glass":                                        "🍷",
+	"wing":                                              "🪽",
+	"winking_face":                                      "😉",
+	"winking_face_with_tongue":                          "😜",
+	"wireless":                                          "🛜",
+	"wolf":                                              "🐺",
+	"woman":                                             "👩",
+	"woman_and_man_holding_hands":                       "👫",
+	"woman_and_man_holding_hands_tone1":                 "👫🏻",
+	"woman_and_man_holding_hands_tone1_tone2": "👩🏻\u200d🤝\u200d👨🏼",
+	"woman_and_man_holding_hands_tone1_tone3": "👩🏻\u200d🤝\u200d👨🏽",
+	"woman_and_man_holding_hands_tone1_tone4": "👩🏻\u200d🤝\u200d👨🏾",
+	"woman_and_man_holding_hands_tone1_tone5": "👩🏻\u200d🤝\u200d👨🏿",
+	"woman_and_man_holding_hands_tone2":       "👫🏼",
+	"woman_and_man_holding_hands_tone2_tone1": "👩🏼\u200d🤝\u200d👨🏻",
+	"woman_and_man_holding_hands_tone2_tone3": "👩🏼\u200d🤝\u200d👨🏽",
+	"woman_and_man_holding_hands_tone2_tone4": "👩🏼\u200d🤝\u200d👨🏾",
+	"woman_and_man_holding_hands_tone2_tone5": "👩🏼\u200d🤝\u200d👨🏿",
+	"woman_and_man_holding_hands_tone3":       "👫🏽",
+	"woman_and_man_holding_hands_tone3_tone1": "👩🏽\u200d🤝\u200d👨🏻",
+	"woman_and_man_holding_hands_tone3_tone2": "👩🏽\u200d🤝\u200d👨🏼",
+	"woman_and_man_holding_hands_tone3_tone4": "👩🏽\u200d🤝\u200d👨🏾",
+	"woman_and_man_holding_hands_tone3_tone5": "👩🏽\u200d🤝\u200d👨🏿",
+	"woman_and_man_holding_hands_tone4":       "👫🏾",
+	"woman_and_man_holding_hands_tone4_tone1": "👩🏾\u200d🤝\u200d👨🏻",
+	"woman_and_man_holding_hands_tone4_tone2": "👩🏾\u200d🤝\u200d👨🏼",
+	"woman_and_man_holding_hands_tone4_tone3": "👩🏾\u200d🤝\u200d👨🏽",
+	"woman_and_man_holding_hands_tone4_tone5": "👩🏾\u200d🤝\u200d👨🏿",
+	"woman_and_man_holding_hands_tone5":       "👫🏿",
+	"woman_and_man_holding_hands_tone5_tone1": "👩🏿\u200d🤝\u200d👨🏻",
+	"woman_and_man_holding_hands_tone5_tone2": "👩🏿\u200d🤝\u200d👨🏼",
+	"woman_and_man_holding_hands_tone5_tone3": "👩🏿\u200d🤝\u200d👨🏽",
+	"woman_and_man_holding_hands_tone5_tone4": "👩🏿\u200d🤝\u200d👨🏾",
+	"woman_artist":                                     "👩\u200d🎨",
+	"woman_artist_tone1":                               "👩🏻\u200d🎨",
+	"woman_artist_tone2":                               "👩🏼\u200d🎨",
+	"woman_artist_tone3":                               "👩🏽\u200d🎨",
+	"woman_artist_tone4":                               "👩🏾\u200d🎨",
+	"woman_artist_tone5":                               "👩🏿\u200d🎨",
+	"woman_astronaut":                                  "👩\u200d🚀",
+	"woman_astronaut_tone1":                            "👩🏻\u200d🚀",
+	"woman_astronaut_tone2":                            "👩🏼\u200d🚀",
+	"woman_astronaut_tone3":                            "👩🏽\u200d🚀",
+	"woman_astronaut_tone4":                            "👩🏾\u200d🚀",
+	"woman_astronaut_tone5":                            "👩🏿\u200d🚀",
+	"woman_bald":                                       "👩\u200d🦲",
+	"woman_beard":                                      "🧔\u200d♀️",
+	"woman_biking":                                     "🚴\u200d♀️",
+	"woman_biking_tone1":                               "🚴🏻\u200d♀️",
+	"woman_biking_tone2":                               "🚴🏼\u200d♀️",
+	"woman_biking_tone3":                               "🚴🏽\u200d♀️",
+	"woman_biking_tone4":                               "🚴🏾\u200d♀️",
+	"woman_biking_tone5":                               "🚴🏿\u200d♀️",
+	"woman_blond_hair":                                 "👱\u200d♀️",
+	"woman_bouncing_ball":                              "⛹️\u200d♀️",
+	"woman_bouncing_ball_tone1":                        "⛹🏻\u200d♀️",
+	"woman_bouncing_ball_tone2":                        "⛹🏼\u200d♀️",
+	"woman_bouncing_ball_tone3":                        "⛹🏽\u200d♀️",
+	"woman_bouncing_ball_tone4":                        "⛹🏾\u200d♀️",
+	"woman_bouncing_ball_tone5":                        "⛹🏿\u200d♀️",
+	"woman_bowing":                                     "🙇\u200d♀️",
+	"woman_bowing_tone1":                               "🙇🏻\u200d♀️",
+	"woman_bowing_tone2":                               "🙇🏼\u200d♀️",
+	"woman_bowing_tone3":                               "🙇🏽\u200d♀️",
+	"woman_bowing_tone4":                               "🙇🏾\u200d♀️",
+	"woman_bowing_tone5":                               "🙇🏿\u200d♀️",
+	"woman_cartwheeling":                               "🤸\u200d♀️",
+	"woman_cartwheeling_tone1":                         "🤸🏻\u200d♀️",
+	"woman_cartwheeling_tone2":                         "🤸🏼\u200d♀️",
+	"woman_cartwheeling_tone3":                         "🤸🏽\u200d♀️",
+	"woman_cartwheeling_tone4":                         "🤸🏾\u200d♀️",
+	"woman_cartwheeling_tone5":                         "🤸🏿\u200d♀️",
+	"woman_climbing":                                   "🧗\u200d♀️",
+	"woman_climbing_tone1":                             "🧗🏻\u200d♀️",
+	"woman_climbing_tone2":                             "🧗🏼\u200d♀️",
+	"woman_climbing_tone3":                             "🧗🏽\u200d♀️",
+	"woman_climbing_tone4":                             "🧗🏾\u200d♀️",
+	"woman_climbing_tone5":                             "🧗🏿\u200d♀️",
+	"woman_construction_worker":                        "👷\u200d♀️",
+	"woman_construction_worker_tone1":                  "👷🏻\u200d♀️",
+	"woman_construction_worker_tone2":                  "👷🏼\u200d♀️",
+	"woman_construction_worker_tone3":                  "👷🏽\u200d♀️",
+	"woman_construction_worker_tone4":                  "👷🏾\u200d♀️",
+	"woman_construction_worker_tone5":                  "👷🏿\u200d♀️",
+	"woman_cook":                                       "👩\u200d🍳",
+	"woman_cook_tone1":                                 "👩🏻\u200d🍳",
+	"woman_cook_tone2":                                 "👩🏼\u200d🍳",
+	"woman_cook_tone3":                                 "👩🏽\u200d🍳",
+	"woman_cook_tone4":                                 "👩🏾\u200d🍳",
+	"woman_cook_tone5":                                 "👩🏿\u200d🍳",
+	"woman_curly_hair":                                 "👩\u200d🦱",
+	"woman_dancing":                                    "💃",
+	"woman_dancing_tone1":                              "💃🏻",
+	"woman_dancing_tone2":                              "💃🏼",
+	"woman_dancing_tone3":                              "💃🏽",
+	"woman_dancing_tone4":                              "💃🏾",
+	"woman_dancing_tone5":                              "💃🏿",
+	"woman_detective":                                  "🕵️\u200d♀️",
+	"woman_detective_tone1":                            "🕵🏻\u200d♀️",
+	"woman_detective_tone2":                            "🕵🏼\u200d♀️",
+	"woman_detective_tone3":                            "🕵🏽\u200d♀️",
+	"woman_detective_tone4":                            "🕵🏾\u200d♀️",
+	"woman_detective_tone5":                            "🕵🏿\u200d♀️",
+	"woman_elf":                                        "🧝\u200d♀️",
+	"woman_elf_tone1":                                  "🧝🏻\u200d♀️",
+	"woman_elf_tone2":                                  "🧝🏼\u200d♀️",
+	"woman_elf_tone3":                                  "🧝🏽\u200d♀️",
+	"woman_elf_tone4":                                  "🧝🏾\u200d♀️",
+	"woman_elf_tone5":                                  "🧝🏿\u200d♀️",
+	"woman_facepalming":                                "🤦\u200d♀️",
+	"woman_facepalming_tone1":                          "🤦🏻\u200d♀️",
+	"woman_facepalming_tone2":                          "🤦🏼\u200d♀️",
+	"woman_facepalming_tone3":                          "🤦🏽\u200d♀️",
+	"woman_facepalming_tone4":                          "🤦🏾\u200d♀️",
+	"woman_facepalming_tone5":                          "🤦🏿\u200d♀️",
+	"woman_factory_worker":                             "👩\u200d🏭",
+	"woman_factory_worker_tone1":                       "👩🏻\u200d🏭",
+	"woman_factory_worker_tone2":                       "👩🏼\u200d🏭",
+	"woman_factory_worker_tone3":                       "👩🏽\u200d🏭",
+	"woman_factory_worker_tone4":                       "👩🏾\u200d🏭",
+	"woman_factory_worker_tone5":                       "👩🏿\u200d🏭",
+	"woman_fairy":                                      "🧚\u200d♀️",
+	"woman_fairy_tone1":                                "🧚🏻\u200d♀️",
+	"woman_fairy_tone2":                                "🧚🏼\u200d♀️",
+	"woman_fairy_tone3":                                "🧚🏽\u200d♀️",
+	"woman_fairy_tone4":                                "🧚🏾\u200d♀️",
+	"woman_fairy_tone5":                                "🧚🏿\u200d♀️",
+	"woman_farmer":                                     "👩\u200d🌾",
+	"woman_farmer_tone1":                               "👩🏻\u200d🌾",
+	"woman_farmer_tone2":                               "👩🏼\u200d🌾",
+	"woman_farmer_tone3":                               "👩🏽\u200d🌾",
+	"woman_farmer_tone4":                               "👩🏾\u200d🌾",
+	"woman_farmer_tone5":                               "👩🏿\u200d🌾",
+	"woman_feeding_baby":                               "👩\u200d🍼",
+	"woman_feeding_baby_tone1":                         "👩🏻\u200d🍼",
+	"woman_feeding_baby_tone2":                         "👩🏼\u200d🍼",
+	"woman_feeding_baby_tone3":                         "👩🏽\u200d🍼",
+	"woman_feeding_baby_tone4":                         "👩🏾\u200d🍼",
+	"woman_feeding_baby_tone5":                         "👩🏿\u200d🍼",
+	"woman_firefighter":                                "👩\u200d🚒",
+	"woman_firefighter_tone1":                          "👩🏻\u200d🚒",
+	"woman_firefighter_tone2":                          "👩🏼\u200d🚒",
+	"woman_firefighter_tone3":                          "👩🏽\u200d🚒",
+	"woman_firefighter_tone4":                          "👩🏾\u200d🚒",
+	"woman_firefighter_tone5":                          "👩🏿\u200d🚒",
+	"woman_frowning":                                   "🙍\u200d♀️",
+	"woman_frowning_tone1":                             "🙍🏻\u200d♀️",
+	"woman_frowning_tone2":                             "🙍🏼\u200d♀️",
+	"woman_frowning_tone3":                             "🙍🏽\u200d♀️",
+	"woman_frowning_tone4":                             "🙍🏾\u200d♀️",
+	"woman_frowning_tone5":                             "🙍🏿\u200d♀️",
+	"woman_genie":                                      "🧞\u200d♀️",
+	"woman_gesturing_no":                               "🙅\u200d♀️",
+	"woman_gesturing_no_tone1":                         "🙅🏻\u200d♀️",
+	"woman_gesturing_no_tone2":                         "🙅🏼\u200d♀️",
+	"woman_gesturing_no_tone3":                         "🙅🏽\u200d♀️",
+	"woman_gesturing_no_tone4":                         "🙅🏾\u200d♀️",
+	"woman_gesturing_no_tone5":                         "🙅🏿\u200d♀️",
+	"woman_gesturing_ok":                               "🙆\u200d♀️",
+	"woman_gesturing_ok_tone1":                         "🙆🏻\u200d♀️",
+	"woman_gesturing_ok_tone2":                         "🙆🏼\u200d♀️",
+	"woman_gesturing_ok_tone3":                         "🙆🏽\u200d♀️",
+	"woman_gesturing_ok_tone4":                         "🙆🏾\u200d♀️",
+	"woman_gesturing_ok_tone5":                         "🙆🏿\u200d♀️",
+	"woman_getting_haircut":                            "💇\u200d♀️",
+	"woman_getting_haircut_tone1":                      "💇🏻\u200d♀️",
+	"woman_getting_haircut_tone2":                      "💇🏼\u200d♀️",
+	"woman_getting_haircut_tone3":                      "💇🏽\u200d♀️",
+	"woman_getting_haircut_tone4":                      "💇🏾\u200d♀️",
+	"woman_getting_haircut_tone5":                      "💇🏿\u200d♀️",
+	"woman_getting_massage":                            "💆\u200d♀️",
+	"woman_getting_massage_tone1":                      "💆🏻\u200d♀️",
+	"woman_getting_massage_tone2":                      "💆🏼\u200d♀️",
+	"woman_getting_massage_tone3":                      "💆🏽\u200d♀️",
+	"woman_getting_massage_tone4":                      "💆🏾\u200d♀️",
+	"woman_getting_massage_tone5":                      "💆🏿\u200d♀️",
+	"woman_golfing":                                    "🏌️\u200d♀️",
+	"woman_golfing_tone1":                              "🏌🏻\u200d♀️",
+	"woman_golfing_tone2":                              "🏌🏼\u200d♀️",
+	"woman_golfing_tone3":                              "🏌🏽\u200d♀️",
+	"woman_golfing_tone4":                              "🏌🏾\u200d♀️",
+	"woman_golfing_tone5":                              "🏌🏿\u200d♀️",
+	"woman_guard":                                      "💂\u200d♀️",
+	"woman_guard_tone1":                                "💂🏻\u200d♀️",
+	"woman_guard_tone2":                                "💂🏼\u200d♀️",
+	"woman_guard_tone3":                                "💂🏽\u200d♀️",
+	"woman_guard_tone4":                                "💂🏾\u200d♀️",
+	"woman_guard_tone5":                                "💂🏿\u200d♀️",
+	"woman_health_worker":                              "👩\u200d⚕️",
+	"woman_health_worker_tone1":                        "👩🏻\u200d⚕️",
+	"woman_health_worker_tone2":                        "👩🏼\u200d⚕️",
+	"woman_health_worker_tone3":                        "👩🏽\u200d⚕️",
+	"woman_health_worker_tone4":                        "👩🏾\u200d⚕️",
+	"woman_health_worker_tone5":                        "👩🏿\u200d⚕️",
+	"woman_in_lotus_position":                          "🧘\u200d♀️",
+	"woman_in_lotus_position_tone1":                    "🧘🏻\u200d♀️",
+	"woman_in_lotus_position_tone2":                    "🧘🏼\u200d♀️",
+	"woman_in_lotus_position_tone3":                    "🧘🏽\u200d♀️",
+	"woman_in_lotus_position_tone4":                    "🧘🏾\u200d♀️",
+	"woman_in_lotus_position_tone5":                    "🧘🏿\u200d♀️",
+	"woman_in_manual_wheelchair":                       "👩\u200d🦽",
+	"woman_in_manual_wheelchair_facing_right":          "👩\u200d🦽\u200d➡️",
+	"woman_in_manual_wheelchair_facing_right_tone1":    "👩🏻\u200d🦽\u200d➡️",
+	"woman_in_manual_wheelchair_facing_right_tone2":    "👩🏼\u200d🦽\u200d➡️",
+	"woman_in_manual_wheelchair_facing_right_tone3":    "👩🏽\u200d🦽\u200d➡️",
+	"woman_in_manual_wheelchair_facing_right_tone4":    "👩🏾\u200d🦽\u200d➡️",
+	"woman_in_manual_wheelchair_facing_right_tone5":    "👩🏿\u200d🦽\u200d➡️",
+	"woman_in_manual_wheelchair_tone1":                 "👩🏻\u200d🦽",
+	"woman_in_manual_wheelchair_tone2":                 "👩🏼\u200d🦽",
+	"woman_in_manual_wheelchair_tone3":                 "👩🏽\u200d🦽",
+	"woman_in_manual_wheelchair_tone4":                 "👩🏾\u200d🦽",
+	"woman_in_manual_wheelchair_tone5":                 "👩🏿\u200d🦽",
+	"woman_in_motorized_wheelchair":                    "👩\u200d🦼",
+	"woman_in_motorized_wheelchair_facing_right":       "👩\u200d🦼\u200d➡️",
+	"woman_in_motorized_wheelchair_facing_right_tone1": "👩🏻\u200d🦼\u200d➡️",
+	"woman_in_motorized_wheelchair_facing_right_tone2": "👩🏼\u200d🦼\u200d➡️",
+	"woman_in_motorized_wheelchair_facing_right_tone3": "👩🏽\u200d🦼\u200d➡️",
+	"woman_in_motorized_wheelchair_facing_right_tone4": "👩🏾\u200d🦼\u200d➡️",
+	"woman_in_motorized_wheelchair_facing_right_tone5": "👩🏿\u200d🦼\u200d➡️",
+	"woman_in_motorized_wheelchair_tone1":              "👩🏻\u200d🦼",
+	"woman_in_motorized_wheelchair_tone2":              "👩🏼\u200d🦼",
+	"woman_in_motorized_wheelchair_tone3":              "👩🏽\u200d🦼",
+	"woman_in_motorized_wheelchair_tone4":              "👩🏾\u200d🦼",
+	"woman_in_motorized_wheelchair_tone5":              "👩🏿\u200d🦼",
+	"woman_in_steamy_room":                             "🧖\u200d♀️",
+	"woman_in_steamy_room_tone1":                       "🧖🏻\u200d♀️",
+	"woman_in_steamy_room_tone2":                       "🧖🏼\u200d♀️",
+	"woman_in_steamy_room_tone3":                       "🧖🏽\u200d♀️",
+	"woman_in_steamy_room_tone4":                       "🧖🏾\u200d♀️",
+	"woman_in_steamy_room_tone5":                       "🧖🏿\u200d♀️",
+	"woman_in_tuxedo":                                  "🤵\u200d♀️",
+	"woman_in_tuxedo_tone1":                            "🤵🏻\u200d♀️",
+	"woman_in_tuxedo_tone2":                            "🤵🏼\u200d♀️",
+	"woman_in_tuxedo_tone3":                            "🤵🏽\u200d♀️",
+	"woman_in_tuxedo_tone4":                            "🤵🏾\u200d♀️",
+	"woman_in_tuxedo_tone5":                            "🤵🏿\u200d♀️",
+	"woman_judge":                                      "👩\u200d⚖️",
+	"woman_judge_tone1":                                "👩🏻\u200d⚖️",
+	"woman_judge_tone2":                                "👩🏼\u200d⚖️",
+	"woman_judge_tone3":                                "👩🏽\u200d⚖️",
+	"woman_judge_tone4":                                "👩🏾\u200d⚖️",
+	"woman_judge_tone5":                                "👩🏿\u200d⚖️",
+	"woman_juggling":                                   "🤹\u200d♀️",
+	"woman_juggling_tone1":                             "🤹🏻\u200d♀️",
+	"woman_juggling_tone2":                             "🤹🏼\u200d♀️",
+	"woman_juggling_tone3":                             "🤹🏽\u200d♀️",
+	"woman_juggling_tone4":                             "🤹🏾\u200d♀️",
+	"woman_juggling_tone5":                             "🤹🏿\u200d♀️",
+	"woman_kneeling":                                   "🧎\u200d♀️",
+	"woman_kneeling_facing_right":                      "🧎\u200d♀️\u200d➡️",
+	"woman_kneeling_facing_right_tone1":                "🧎🏻\u200d♀️\u200d➡️",
+	"woman_kneeling_facing_right_tone2":                "🧎🏼\u200d♀️\u200d➡️",
+	"woman_kneeling_facing_right_tone3":                "🧎🏽\u200d♀️\u200d➡️",
+	"woman_kneeling_facing_right_tone4":                "🧎🏾\u200d♀️\u200d➡️",
+	"woman_kneeling_facing_right_tone5":                "🧎🏿\u200d♀️\u200d➡️",
+	"woman_kneeling_tone1":                             "🧎🏻\u200d♀️",
+	"woman_kneeling_tone2":                             "🧎🏼\u200d♀️",
+	"woman_kneeling_tone3":                             "🧎🏽\u200d♀️",
+	"woman_kneeling_tone4":                             "🧎🏾\u200d♀️",
+	"woman_kneeling_tone5":                             "🧎🏿\u200d♀️",
+	"woman_lifting_weights":                            "🏋️\u200d♀️",
+	"woman_lifting_weights_tone1":                      "🏋🏻\u200d♀️",
+	"woman_lifting_weights_tone2":                      "🏋🏼\u200d♀️",
+	"woman_lifting_weights_tone3":                      "🏋🏽\u200d♀️",
+	"woman_lifting_weights_tone4":                      "🏋🏾\u200d♀️",
+	"woman_lifting_weights_tone5":                      "🏋🏿\u200d♀️",
+	"woman_mage":                                       "🧙\u200d♀️",
+	"woman_mage_tone1":                                 "🧙🏻\u200d♀️",
+	"woman_mage_tone2":                                 "🧙🏼\u200d♀️",
+	"woman_mage_tone3":                                 "🧙🏽\u200d♀️",
+	"woman_mage_tone4":                                 "🧙🏾\u200d♀️",
+	"woman_mage_tone5":                                 "🧙🏿\u200d♀️",
+	"woman_mechanic":                                   "👩\u200d🔧",
+	"woman_mechanic_tone1":                             "👩🏻\u200d🔧",
+	"woman_mechanic_tone2":                             "👩🏼\u200d🔧",
+	"woman_mechanic_tone3":                             "👩🏽\u200d🔧",
+	"woman_mechanic_tone4":                             "👩🏾\u200d🔧",
+	"woman_mechanic_tone5":                             "👩🏿\u200d🔧",
+	"woman_mountain_biking":                            "🚵\u200d♀️",
+	"woman_mountain_biking_tone1":                      "🚵🏻\u200d♀️",
+	"woman_mountain_biking_tone2":                      "🚵🏼\u200d♀️",
+	"woman_mountain_biking_tone3":                      "🚵🏽\u200d♀️",
+	"woman_mountain_biking_tone4":                      "🚵🏾\u200d♀️",
+	"woman_mountain_biking_tone5":                      "🚵🏿\u200d♀️",
+	"woman_office_worker":                              "👩\u200d💼",
+	"woman_office_worker_tone1":                        "👩🏻\u200d💼",
+	"woman_office_worker_tone2":                        "👩🏼\u200d💼",
+	"woman_office_worker_tone3":                        "👩🏽\u200d💼",
+	"woman_office_worker_tone4":                        "👩🏾\u200d💼",
+	"woman_office_worker_tone5":                        "👩🏿\u200d💼",
+	"woman_pilot":                                      "👩\u200d✈️",
+	"woman_pilot_tone1":                                "👩🏻\u200d✈️",
+	"woman_pilot_tone2":                                "👩🏼\u200d✈️",
+	"woman_pilot_tone3":                                "👩🏽\u200d✈️",
+	"woman_pilot_tone4":                                "👩🏾\u200d✈️",
+	"woman_pilot_tone5":                                "👩🏿\u200d✈️",
+	"woman_playing_handball":                           "🤾\u200d♀️",
+	"woman_playing_handball_tone1":                     "🤾🏻\u200d♀️",
+	"woman_playing_handball_tone2":                     "🤾🏼\u200d♀️",
+	"woman_playing_handball_tone3":                     "🤾🏽\u200d♀️",
+	"woman_playing_handball_tone4":                     "🤾🏾\u200d♀️",
+	"woman_playing_handball_tone5":                     "🤾🏿\u200d♀️",
+	"woman_playing_water_polo":                         "🤽\u200d♀️",
+	"woman_playing_water_polo_tone1":                   "🤽🏻\u200d♀️",
+	"woman_playing_water_polo_tone2":                   "🤽🏼\u200d♀️",
+	"woman_playing_water_polo_tone3":                   "🤽🏽\u200d♀️",
+	"woman_playing_water_polo_tone4":                   "🤽🏾\u200d♀️",
+	"woman_playing_water_polo_tone5":                   "🤽🏿\u200d♀️",
+	"woman_police_officer":                             "👮\u200d♀️",
+	"woman_police_officer_tone1":                       "👮🏻\u200d♀️",
+	"woman_police_officer_tone2":                       "👮🏼\u200d♀️",
+	"woman_police_officer_tone3":                       "👮🏽\u200d♀️",
+	"woman_police_officer_tone4":                       "👮🏾\u200d♀️",
+	"woman_police_officer_tone5":                       "👮🏿\u200d♀️",
+	"woman_pouting":                                    "🙎\u200d♀️",
+	"woman_pouting_tone1":                              "🙎🏻\u200d♀️",
+	"woman_pouting_tone2":                              "🙎🏼\u200d♀️",
+	"woman_pouting_tone3":                              "🙎🏽\u200d♀️",
+	"woman_pouting_tone4":                              "🙎🏾\u200d♀️",
+	"woman_pouting_tone5":                              "🙎🏿\u200d♀️",
+	"woman_raising_hand":                               "🙋\u200d♀️",
+	"woman_raising_hand_tone1":                         "🙋🏻\u200d♀️",
+	"woman_raising_hand_tone2":                         "🙋🏼\u200d♀️",
+	"woman_raising_hand_tone3":                         "🙋🏽\u200d♀️",
+	"woman_raising_hand_tone4":                         "🙋🏾\u200d♀️",
+	"woman_raising_hand_tone5":                         "🙋🏿\u200d♀️",
+	"woman_red_hair":                                   "👩\u200d🦰",
+	"woman_rowing_boat":                                "🚣\u200d♀️",
+	"woman_rowing_boat_tone1":                          "🚣🏻\u200d♀️",
+	"woman_rowing_boat_tone2":                          "🚣🏼\u200d♀️",
+	"woman_rowing_boat_tone3":                          "🚣🏽\u200d♀️",
+	"woman_rowing_boat_tone4":                          "🚣🏾\u200d♀️",
+	"woman_rowing_boat_tone5":                          "🚣🏿\u200d♀️",
+	"woman_running":                                    "🏃\u200d♀️",
+	"woman_running_facing_right":                       "🏃\u200d♀️\u200d➡️",
+	"woman_running_facing_right_tone1":                 "🏃🏻\u200d♀️\u200d➡️",
+	"woman_running_facing_right_tone2":                 "🏃🏼\u200d♀️\u200d➡️",
+	"woman_running_facing_right_tone3":                 "🏃🏽\u200d♀️\u200d➡️",
+	"woman_running_facing_right_tone4":                 "🏃🏾\u200d♀️\u200d➡️",
+	"woman_running_facing_right_tone5":                 "🏃🏿\u200d♀️\u200d➡️",
+	"woman_running_tone1":                              "🏃🏻\u200d♀️",
+	"woman_running_tone2":                              "🏃🏼\u200d♀️",
+	"woman_running_tone3":                              "🏃🏽\u200d♀️",
+	"woman_running_tone4":                              "🏃🏾\u200d♀️",
+	"woman_running_tone5":                              "🏃🏿\u200d♀️",
+	"woman_scientist":                                  "👩\u200d🔬",
+	"woman_scientist_tone1":                            "👩🏻\u200d🔬",
+	"woman_scientist_tone2":                            "👩🏼\u200d🔬",
+	"woman_scientist_tone3":                            "👩🏽\u200d🔬",
+	"woman_scientist_tone4":                            "👩🏾\u200d🔬",
+	"woman_scientist_tone5":                            "👩🏿\u200d🔬",
+	"woman_shrugging":                                  "🤷\u200d♀️",
+	"woman_shrugging_tone1":                            "🤷🏻\u200d♀️",
+	"woman_shrugging_tone2":                            "🤷🏼\u200d♀️",
+	"woman_shrugging_tone3":                            "🤷🏽\u200d♀️",
+	"woman_shrugging_tone4":                            "🤷🏾\u200d♀️",
+	"woman_shrugging_tone5":                            "🤷🏿\u200d♀️",
+	"woman_singer":                                     "👩\u200d🎤",
+	"woman_singer_tone1":                               "👩🏻\u200d🎤",
+	"woman_singer_tone2":                               "👩🏼\u200d🎤",
+	"woman_singer_tone3":                               "👩🏽\u200d🎤",
+	"woman_singer_tone4":                               "👩🏾\u200d🎤",
+	"woman_singer_tone5":                               "👩🏿\u200d🎤",
+	"woman_standing":                                   "🧍\u200d♀️",
+	"woman_standing_tone1":                             "🧍🏻\u200d♀️",
+	"woman_standing_tone2":                             "🧍🏼\u200d♀️",
+	"woman_standing_tone3":                             "🧍🏽\u200d♀️",
+	"woman_standing_tone4":                             "🧍🏾\u200d♀️",
+	"woman_standing_tone5":                             "🧍🏿\u200d♀️",
+	"woman_student":                                    "👩\u200d🎓",
+	"woman_student_tone1":                              "👩🏻\u200d🎓",
+	"woman_student_tone2":                              "👩🏼\u200d🎓",
+	"woman_student_tone3":                              "👩🏽\u200d🎓",
+	"woman_student_tone4":                              "👩🏾\u200d🎓",
+	"woman_student_tone5":                              "👩🏿\u200d🎓",
+	"woman_superhero":                                  "🦸\u200d♀️",
+	"woman_superhero_tone1":                            "🦸🏻\u200d♀️",
+	"woman_superhero_tone2":                            "🦸🏼\u200d♀️",
+	"woman_superhero_tone3":                            "🦸🏽\u200d♀️",
+	"woman_superhero_tone4":                            "🦸🏾\u200d♀️",
+	"woman_superhero_tone5":                            "🦸🏿\u200d♀️",
+	"woman_supervillain":                               "🦹\u200d♀️",
+	"woman_supervillain_tone1":                         "🦹🏻\u200d♀️",
+	"woman_supervillain_tone2":                         "🦹🏼\u200d♀️",
+	"woman_supervillain_tone3":                         "🦹🏽\u200d♀️",
+	"woman_supervillain_tone4":                         "🦹🏾\u200d♀️",
+	"woman_supervillain_tone5":                         "🦹🏿\u200d♀️",
+	"woman_surfing":                                    "🏄\u200d♀️",
+	"woman_surfing_tone1":                              "🏄🏻\u200d♀️",
+	"woman_surfing_tone2":                              "🏄🏼\u200d♀️",
+	"woman_surfing_tone3":                              "🏄🏽\u200d♀️",
+	"woman_surfing_tone4":                              "🏄🏾\u200d♀️",
+	"woman_surfing_tone5":                              "🏄🏿\u200d♀️",
+	"woman_swimming":                                   "🏊\u200d♀️",
+	"woman_swimming_tone1":                             "🏊🏻\u200d♀️",
+	"woman_swimming_tone2":                             "🏊🏼\u200d♀️",
+	"woman_swimming_tone3":                             "🏊🏽\u200d♀️",
+	"woman_swimming_tone4":                             "🏊🏾\u200d♀️",
+	"woman_swimming_tone5":                             "🏊🏿\u200d♀️",
+	"woman_teacher":                                    "👩\u200d🏫",
+	"woman_teacher_tone1":                              "👩🏻\u200d🏫",
+	"woman_teacher_tone2":                              "👩🏼\u200d🏫",
+	"woman_teacher_tone3":                              "👩🏽\u200d🏫",
+	"woman_teacher_tone4":                              "👩🏾\u200d🏫",
+	"woman_teacher_tone5":                              "👩🏿\u200d🏫",
+	"woman_technologist":                               "👩\u200d💻",
+	"woman_technologist_tone1":                         "👩🏻\u200d💻",
+	"woman_technologist_tone2":                         "👩🏼\u200d💻",
+	"woman_technologist_tone3":                         "👩🏽\u200d💻",
+	"woman_technologist_tone4":                         "👩🏾\u200d💻",
+	"woman_technologist_tone5":                         "👩🏿\u200d💻",
+	"woman_tipping_hand":                               "💁\u200d♀️",
+	"woman_tipping_hand_tone1":                         "💁🏻\u200d♀️",
+	"woman_tipping_hand_tone2":                         "💁🏼\u200d♀️",
+	"woman_tipping_hand_tone3":                         "💁🏽\u200d♀️",
+	"woman_tipping_hand_tone4":                         "💁🏾\u200d♀️",
+	"woman_tipping_hand_tone5":                         "💁🏿\u200d♀️",
+	"woman_tone1":                                      "👩🏻",
+	"woman_tone1_bald":                                 "👩🏻\u200d🦲",
+	"woman_tone1_beard":                                "🧔🏻\u200d♀️",
+	"woman_tone1_blond_hair":                           "👱🏻\u200d♀️",
+	"woman_tone1_curly_hair":                           "👩🏻\u200d🦱",
+	"woman_tone1_red_hair":                             "👩🏻\u200d🦰",
+	"woman_tone1_white_hair":                           "👩🏻\u200d🦳",
+	"woman_tone2":                                      "👩🏼",
+	"woman_tone2_bald":                                 "👩🏼\u200d🦲",
+	"woman_tone2_beard":                                "🧔🏼\u200d♀️",
+	"woman_tone2_blond_hair":                           "👱🏼\u200d♀️",
+	"woman_tone2_curly_hair":                           "👩🏼\u200d🦱",
+	"woman_tone2_red_hair":                             "👩🏼\u200d🦰",
+	"woman_tone2_white_hair":                           "👩🏼\u200d🦳",
+	"woman_tone3":                                      "👩🏽",
+	"woman_tone3_bald":                                 "👩🏽\u200d🦲",
+	"woman_tone3_beard":                                "🧔🏽\u200d♀️",
+	"woman_tone3_blond_hair":                           "👱🏽\u200d♀️",
+	"woman_tone3_curly_hair":                           "👩🏽\u200d🦱",
+	"woman_tone3_red_hair":                             "👩🏽\u200d🦰",
+	"woman_tone3_white_hair":                           "👩🏽\u200d🦳",
+	"woman_tone4":                                      "👩🏾",
+	"woman_tone4_bald":                                 "👩🏾\u200d🦲",
+	"woman_tone4_beard":                                "🧔🏾\u200d♀️",
+	"woman_tone4_blond_hair":                           "👱🏾\u200d♀️",
+	"woman_tone4_curly_hair":                           "👩🏾\u200d🦱",
+	"woman_tone4_red_hair":                             "👩🏾\u200d🦰",
+	"woman_tone4_white_hair":                           "👩🏾\u200d🦳",
+	"woman_tone5":                                      "👩🏿",
+	"woman_tone5_bald":                                 "👩🏿\u200d🦲",
+	"woman_tone5_beard":                                "🧔🏿\u200d♀️",
+	"woman_tone5_blond_hair":                           "👱🏿\u200d♀️",
+	"woman_tone5_curly_hair":                           "👩🏿\u200d🦱",
+	"woman_tone5_red_hair":                             "👩🏿\u200d🦰",
+	"woman_tone5_white_hair":                           "👩🏿\u200d🦳",
+	"woman_vampire":                                    "🧛\u200d♀️",
+	"woman_vampire_tone1":                              "🧛🏻\u200d♀️",
+	"woman_vampire_tone2":                              "🧛🏼\u200d♀️",
+	"woman_vampire_tone3":                              "🧛🏽\u200d♀️",
+	"woman_vampire_tone4":                              "🧛🏾\u200d♀️",
+	"woman_vampire_tone5":                              "🧛🏿\u200d♀️",
+	"woman_walking":                                    "🚶\u200d♀️",
+	"woman_walking_facing_right":                       "🚶\u200d♀️\u200d➡️",
+	"woman_walking_facing_right_tone1":                 "🚶🏻\u200d♀️\u200d➡️",
+	"woman_walking_facing_right_tone2":                 "🚶🏼\u200d♀️\u200d➡️",
+	"woman_walking_facing_right_tone3":                 "🚶🏽\u200d♀️\u200d➡️",
+	"woman_walking_facing_right_tone4":                 "🚶🏾\u200d♀️\u200d➡️",
+	"woman_walking_facing_right_tone5":                 "🚶🏿\u200d♀️\u200d➡️",
+	"woman_walking_tone1":                              "🚶🏻\u200d♀️",
+	"woman_walking_tone2":                              "🚶🏼\u200d♀️",
+	"woman_walking_tone3":                              "🚶🏽\u200d♀️",
+	"woman_walking_tone4":                              "🚶🏾\u200d♀️",
+	"woman_walking_tone5":                              "🚶🏿\u200d♀️",
+	"woman_wearing_turban":                             "👳\u200d♀️",
+	"woman_wearing_turban_tone1":                       "👳🏻\u200d♀️",
+	"woman_wearing_turban_tone2":                       "👳🏼\u200d♀️",
+	"woman_wearing_turban_tone3":                       "👳🏽\u200d♀️",
+	"woman_wearing_turban_tone4":                       "👳🏾\u200d♀️",
+	"woman_wearing_turban_tone5":                       "👳🏿\u200d♀️",
+	"woman_white_hair":                                 "👩\u200d🦳",
+	"woman_with_headscarf":                             "🧕",
+	"woman_with_headscarf_tone1":                       "🧕🏻",
+	"woman_with_headscarf_tone2":                       "🧕🏼",
+	"woman_with_headscarf_tone3":                       "🧕🏽",
+	"woman_with_headscarf_tone4":                       "🧕🏾",
+	"woman_with_headscarf_tone5":                       "🧕🏿",
+	"woman_with_veil":                                  "👰\u200d♀️",
+	"woman_with_veil_tone1":                            "👰🏻\u200d♀️",
+	"woman_with_veil_tone2":                            "👰🏼\u200d♀️",
+	"woman_with_veil_tone3":                            "👰🏽\u200d♀️",
+	"woman_with_veil_tone4":                            "👰🏾\u200d♀️",
+	"woman_with_veil_tone5":                            "👰🏿\u200d♀️",
+	"woman_with_white_cane":                            "👩\u200d🦯",
+	"woman_with_white_cane_facing_right":               "👩\u200d🦯\u200d➡️",
+	"woman_with_white_cane_facing_right_tone1":         "👩🏻\u200d🦯\u200d➡️",
+	"woman_with_white_cane_facing_right_tone2":         "👩🏼\u200d🦯\u200d➡️",
+	"woman_with_white_cane_facing_right_tone3":         "👩🏽\u200d🦯\u200d➡️",
+	"woman_with_white_cane_facing_right_tone4":         "👩🏾\u200d🦯\u200d➡️",
+	"woman_with_white_cane_facing_right_tone5":         "👩🏿\u200d🦯\u200d➡️",
+	"woman_with_white_cane_tone1":                      "👩🏻\u200d🦯",
+	"woman_with_white_cane_tone2":                      "👩🏼\u200d🦯",
+	"woman_with_white_cane_tone3":                      "👩🏽\u200d🦯",
+	"woman_with_white_cane_tone4":                      "👩🏾\u200d🦯",
+	"woman_with_white_cane_tone5":                      "👩🏿\u200d🦯",
+	"woman_zombie":                                     "🧟\u200d♀️",
+	"womans_boot":                                      "👢",
+	"womans_clothes":                                   "👚",
+	"womans_hat":                                       "👒",
+	"womans_sandal":                                    "👡",
+	"women_holding_hands":                              "👭",
+	"women_holding_hands_tone1":                        "👭🏻",
+	"women_holding_hands_tone1_tone2":                  "👩🏻\u200d🤝\u200d👩🏼",
+	"women_holding_hands_tone1_tone3":                  "👩🏻\u200d🤝\u200d👩🏽",
+	"women_holding_hands_tone1_tone4":                  "👩🏻\u200d🤝\u200d👩🏾",
+	"women_holding_hands_tone1_tone5":                  "👩🏻\u200d🤝\u200d👩🏿",
+	"women_holding_hands_tone2":                        "👭🏼",
+	"women_holding_hands_tone2_tone1":                  "👩🏼\u200d🤝\u200d👩🏻",
+	"women_holding_hands_tone2_tone3":                  "👩🏼\u200d🤝\u200d👩🏽",
+	"women_holding_hands_tone2_tone4":                  "👩🏼\u200d🤝\u200d👩🏾",
+	"women_holding_hands_tone2_tone5":                  "👩🏼\u200d🤝\u200d👩🏿",
+	"women_holding_hands_tone3":                        "👭🏽",
+	"women_holding_hands_tone3_tone1":                  "👩🏽\u200d🤝\u200d👩🏻",
+	"women_holding_hands_tone3_tone2":                  "👩🏽\u200d🤝\u200d👩🏼",
+	"women_holding_hands_tone3_tone4":                  "👩🏽\u200d🤝\u200d👩🏾",
+	"women_holding_hands_tone3_tone5":                  "👩🏽\u200d🤝\u200d👩🏿",
+	"women_holding_hands_tone4":                        "👭🏾",
+	"women_holding_hands_tone4_tone1":                  "👩🏾\u200d🤝\u200d👩🏻",
+	"women_holding_hands_tone4_tone2":                  "👩🏾\u200d🤝\u200d👩🏼",
+	"women_holding_hands_tone4_tone3":                  "👩🏾\u200d🤝\u200d👩🏽",
+	"women_holding_hands_tone4_tone5":                  "👩🏾\u200d🤝\u200d👩🏿",
+	"women_holding_hands_tone5":                        "👭🏿",
+	"women_holding_hands_tone5_tone1":                  "👩🏿\u200d🤝\u200d👩🏻",
+	"women_holding_hands_tone5_tone2":                  "👩🏿\u200d🤝\u200d👩🏼",
+	"women_holding_hands_tone5_tone3":                  "👩🏿\u200d🤝\u200d👩🏽",
+	"women_holding_hands_tone5_tone4":                  "👩🏿\u200d🤝\u200d👩🏾",
+	"women_with_bunny_ears":                            "👯\u200d♀️",
+	"women_wrestling":                                  "🤼\u200d♀️",
+	"womens_room":                                      "🚺",
+	"wood":                                             "🪵",
+	"woozy_face":                                       "🥴",
+	"world_map":                                        "🗺️",
+	"worm":                                             "🪱",
+	"worried_face":                                     "😟",
+	"wrapped_gift":                                     "🎁",
+	"wrench":                                           "🔧",
+	"writing_hand":                                     "✍️",
+	"writing_hand_tone1":                               "✍🏻",
+	"writing_hand_tone2":                               "✍🏼",
+	"writing_hand_tone3":                               "✍🏽",
+	"writing_hand_tone4":                               "✍🏾",
+	"writing_hand_tone5":                               "✍🏿",
+	"x_ray":                                            "🩻",
+	"yarn":                                             "🧶",
+	"yawning_face":                                     "🥱",
+	"yellow_circle":                                    "🟡",
+	"yellow_heart":                                     "💛",
+	"yellow_square":                                    "🟨",
+	"yen_banknote":                                     "💴",
+	"yin_yang":                                         "☯️",
+	"yo_yo":                                            "🪀",
+	"zany_face":                                        "🤪",
+	"zebra":                                            "🦓",
+	"zero":                                             "0️⃣",
+	"zipper_mouth_face":                                "🤐",
+	"zombie":                                           "🧟",
+	"zzz":                                              "💤",
+}
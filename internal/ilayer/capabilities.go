@@ -2,6 +2,7 @@ package ilayer
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/tadeokondrak/ircdiscord/internal/replies"
@@ -14,6 +15,30 @@ var supportedCapabilities = []string{
 	"message-tags",
 }
 
+// capabilityList returns the capabilities to advertise, with values if the
+// client supports version 302 of CAP.
+func (c *Client) capabilityList(values bool) []string {
+	capabilities := append([]string(nil), supportedCapabilities...)
+	if mechanisms := c.Server.SASLMechanisms(); len(mechanisms) > 0 {
+		if values {
+			capabilities = append(capabilities,
+				"sasl="+strings.Join(mechanisms, ","))
+		} else {
+			capabilities = append(capabilities, "sasl")
+		}
+	}
+	return capabilities
+}
+
+func (c *Client) isSupportedCapability(capability string) bool {
+	for _, suppcap := range c.capabilityList(false) {
+		if capability == suppcap {
+			return true
+		}
+	}
+	return false
+}
+
 func (c *Client) handleCap(msg *irc.Message) error {
 	if err := checkParamCount(msg, 1, -1); err != nil {
 		return err
@@ -38,7 +63,13 @@ func (c *Client) handleCapLs(msg *irc.Message) error {
 		return err
 	}
 
-	if err := replies.CAP_LS(c, supportedCapabilities); err != nil {
+	values := false
+	if len(msg.Params) == 2 {
+		version, err := strconv.Atoi(msg.Params[1])
+		values = err == nil && version >= 302
+	}
+
+	if err := replies.CAP_LS(c, c.capabilityList(values)); err != nil {
 		return err
 	}
 
@@ -70,16 +101,7 @@ func (c *Client) handleCapReq(msg *irc.Message) error {
 
 	requested := strings.Split(msg.Params[1], " ")
 	for _, capability := range requested {
-		supported := false
-
-		for _, suppcap := range supportedCapabilities {
-			if capability == suppcap {
-				supported = true
-				break
-			}
-		}
-
-		if !supported {
+		if !c.isSupportedCapability(capability) {
 			return fmt.Errorf(
 				"unknown capability requested: %s",
 				capability)
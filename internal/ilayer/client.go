@@ -1,6 +1,7 @@
 package ilayer
 
 import (
+	"fmt"
 	"strings"
 	"time"
 
@@ -19,6 +20,9 @@ type Client struct {
 	username     string
 	realname     string
 	password     string
+	account      string
+	sasl         *saslState // nil unless authenticating
+	saslFailures int
 	isRegistered bool
 	isCapBlocked bool
 }
@@ -90,6 +94,11 @@ func (c *Client) Password() string {
 	return c.password
 }
 
+// Account returns the account logged in to with SASL, or "".
+func (c *Client) Account() string {
+	return c.account
+}
+
 func (c *Client) IsRegistered() bool {
 	return c.isRegistered
 }
@@ -139,11 +148,24 @@ func (c *Client) Join(channel, topic string, created time.Time,
 	return nil
 }
 
+// Message sends content to channel, one PRIVMSG per line. Every line gets
+// tags, except that msgid is made unique by appending the line number to it
+// after the first line.
 func (c *Client) Message(channel, content string, author *irc.Prefix,
-	time time.Time) error {
-	for _, line := range strings.Split(content, "\n") {
+	time time.Time, tags irc.Tags) error {
+	msgid, hasID := tags["msgid"]
+	for i, line := range strings.Split(content, "\n") {
+		if hasID && i > 0 {
+			lineTags := make(irc.Tags, len(tags))
+			for key, value := range tags {
+				lineTags[key] = value
+			}
+			lineTags["msgid"] = irc.TagValue(
+				fmt.Sprintf("%s-%d", msgid, i))
+			tags = lineTags
+		}
 		if err := replies.PRIVMSG(
-			c, time, author, channel, line,
+			c, time, tags, author, channel, line,
 		); err != nil {
 			return err
 		}
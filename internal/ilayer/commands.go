@@ -14,6 +14,8 @@ func (c *Client) HandleMessage(msg *irc.Message) error {
 		return c.handleCap(msg)
 	case "PASS":
 		return c.handlePass(msg)
+	case "AUTHENTICATE":
+		return c.handleAuthenticate(msg)
 	case "NICK":
 		return c.handleNick(msg)
 	case "USER":
@@ -0,0 +1,108 @@
+package ilayer
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/tadeokondrak/ircdiscord/internal/replies"
+	"gopkg.in/irc.v3"
+)
+
+// saslChunkSize is the length of a full AUTHENTICATE parameter, which is
+// followed by more of the same response.
+const saslChunkSize = 400
+
+// saslMaxResponse bounds the length of a SASL response, base64-encoded.
+const saslMaxResponse = 16 * saslChunkSize
+
+// saslMaxFailures is the number of failed logins after which the client is
+// disconnected, so passwords can't be guessed on one connection.
+const saslMaxFailures = 3
+
+type saslState struct {
+	mechanism string
+	response  strings.Builder
+}
+
+func (c *Client) handleAuthenticate(msg *irc.Message) error {
+	if err := checkParamCount(msg, 1, 1); err != nil {
+		return err
+	}
+
+	if !c.capabilities["sasl"] {
+		return replies.ERR_SASLFAIL(c)
+	}
+
+	if c.account != "" {
+		return replies.ERR_SASLALREADY(c)
+	}
+
+	param := msg.Params[0]
+
+	if param == "*" {
+		c.sasl = nil
+		return replies.ERR_SASLABORTED(c)
+	}
+
+	if c.sasl == nil {
+		mechanism := strings.ToUpper(param)
+		mechanisms := c.Server.SASLMechanisms()
+		for _, supported := range mechanisms {
+			if mechanism == supported {
+				c.sasl = &saslState{mechanism: mechanism}
+				return replies.AUTHENTICATE(c, "+")
+			}
+		}
+		if err := replies.RPL_SASLMECHS(c, mechanisms); err != nil {
+			return err
+		}
+		return replies.ERR_SASLFAIL(c)
+	}
+
+	if len(param) > saslChunkSize ||
+		c.sasl.response.Len()+len(param) > saslMaxResponse {
+		c.sasl = nil
+		return replies.ERR_SASLTOOLONG(c)
+	}
+
+	if param != "+" {
+		c.sasl.response.WriteString(param)
+	}
+	if len(param) == saslChunkSize {
+		return nil
+	}
+
+	state := c.sasl
+	c.sasl = nil
+
+	response, err := base64.StdEncoding.DecodeString(state.response.String())
+	if err != nil {
+		return c.saslFail()
+	}
+
+	account, err := c.Server.HandleAuthenticate(state.mechanism, response)
+	if err != nil {
+		return c.saslFail()
+	}
+
+	c.account = account
+
+	if err := replies.RPL_LOGGEDIN(c, account); err != nil {
+		return err
+	}
+
+	return replies.RPL_SASLSUCCESS(c)
+}
+
+// saslFail reports a failed login, and ends the connection after too many.
+func (c *Client) saslFail() error {
+	if err := replies.ERR_SASLFAIL(c); err != nil {
+		return err
+	}
+	c.saslFailures++
+	if c.saslFailures >= saslMaxFailures {
+		return fmt.Errorf("too many failed authentication attempts")
+	}
+	return nil
+}
@@ -22,6 +22,14 @@ type Server interface {
 	HandlePing(nonce string) (string, error)        // During registration
 	HandleRegister() error                          // During registration
 
+	// SASLMechanisms returns the supported SASL mechanisms, which may be
+	// none. During registration.
+	SASLMechanisms() []string
+	// HandleAuthenticate logs in with the response to a SASL mechanism,
+	// returning the account name. Any error fails the authentication
+	// without disconnecting. During registration.
+	HandleAuthenticate(mechanism string, response []byte) (string, error)
+
 	HandleJoin(channel string) error
 	HandleMessage(channel, content string) error
 	HandleList() ([]ListEntry, error)
@@ -0,0 +1,273 @@
+// Package logging writes leveled log lines carrying fields, as text or JSON.
+//
+// Each part of ircdiscord logs through its own subsystem, whose level can be
+// set separately:
+//
+//	server   listeners, clients coming and going, sessions
+//	client   a connected IRC client
+//	irc      raw IRC lines, at debug level
+//	discord  the Discord gateway, at debug level, and its errors
+//	tls      certificates
+//	admin    actions taken through the admin API
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Level is the importance of a log line.
+type Level int
+
+const (
+	Debug Level = iota
+	Info
+	Warn
+	Error
+	// Off disables logging, and is not used for lines.
+	Off
+)
+
+var levelNames = []string{"debug", "info", "warn", "error", "off"}
+
+func (l Level) String() string {
+	if l < Debug || l > Off {
+		return fmt.Sprintf("level(%d)", int(l))
+	}
+	return levelNames[l]
+}
+
+// ParseLevel parses the name of a Level, one of "debug", "info", "warn",
+// "error" or "off".
+func ParseLevel(name string) (Level, error) {
+	for i, other := range levelNames {
+		if name == other {
+			return Level(i), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown log level %s", name)
+}
+
+// Format selects how lines are written.
+type Format int
+
+const (
+	// Text writes lines as the time, level, subsystem and message
+	// followed by key=value fields.
+	Text Format = iota
+	// JSON writes lines as JSON objects, one per line.
+	JSON
+)
+
+// ParseFormat parses the name of a Format, "text" or "json".
+func ParseFormat(name string) (Format, error) {
+	switch name {
+	case "text":
+		return Text, nil
+	case "json":
+		return JSON, nil
+	default:
+		return 0, fmt.Errorf("unknown log format %s", name)
+	}
+}
+
+// Options configures a Logger.
+type Options struct {
+	Format Format
+	Level  Level            // for subsystems not in Levels
+	Levels map[string]Level // subsystem names to levels
+}
+
+// output is shared by a Logger and those derived from it.
+type output struct {
+	mu   sync.Mutex
+	w    io.Writer
+	opts Options
+	now  func() time.Time
+}
+
+// Logger writes log lines. Its methods may be called from multiple
+// goroutines.
+type Logger struct {
+	out       *output
+	subsystem string
+	level     Level
+	fields    []field
+}
+
+type field struct {
+	key   string
+	value interface{}
+}
+
+// New returns a Logger writing to w, without a subsystem.
+func New(w io.Writer, opts Options) *Logger {
+	return &Logger{
+		out:   &output{w: w, opts: opts, now: time.Now},
+		level: opts.Level,
+	}
+}
+
+// Discard returns a Logger that writes nothing.
+func Discard() *Logger {
+	return New(ioutil.Discard, Options{Level: Off})
+}
+
+// Subsystem returns a Logger for a subsystem, at the level set for it.
+func (l *Logger) Subsystem(name string) *Logger {
+	copy := *l
+	copy.subsystem = name
+	copy.level = l.out.opts.Level
+	if level, ok := l.out.opts.Levels[name]; ok {
+		copy.level = level
+	}
+	return &copy
+}
+
+// With returns a Logger adding fields to every line, given as alternating
+// keys and values.
+func (l *Logger) With(keyvals ...interface{}) *Logger {
+	copy := *l
+	copy.fields = make([]field, len(l.fields), len(l.fields)+len(keyvals)/2)
+	for i := range l.fields {
+		copy.fields[i] = l.fields[i]
+	}
+	for i := 0; i+1 < len(keyvals); i += 2 {
+		copy.fields = append(copy.fields,
+			field{fmt.Sprint(keyvals[i]), keyvals[i+1]})
+	}
+	return &copy
+}
+
+// Enabled returns whether lines at level are written.
+func (l *Logger) Enabled(level Level) bool {
+	return level >= l.level && level < Off
+}
+
+func (l *Logger) Debugf(format string, args ...interface{}) {
+	l.logf(Debug, format, args...)
+}
+
+func (l *Logger) Infof(format string, args ...interface{}) {
+	l.logf(Info, format, args...)
+}
+
+func (l *Logger) Warnf(format string, args ...interface{}) {
+	l.logf(Warn, format, args...)
+}
+
+func (l *Logger) Errorf(format string, args ...interface{}) {
+	l.logf(Error, format, args...)
+}
+
+func (l *Logger) logf(level Level, format string, args ...interface{}) {
+	if !l.Enabled(level) {
+		return
+	}
+	l.write(level, fmt.Sprintf(format, args...))
+}
+
+func (l *Logger) write(level Level, msg string) {
+	var b bytes.Buffer
+	now := l.out.now()
+
+	switch l.out.opts.Format {
+	case JSON:
+		b.WriteString(`{"time":`)
+		writeJSON(&b, now.Format(time.RFC3339Nano))
+		b.WriteString(`,"level":`)
+		writeJSON(&b, level.String())
+		if l.subsystem != "" {
+			b.WriteString(`,"subsystem":`)
+			writeJSON(&b, l.subsystem)
+		}
+		b.WriteString(`,"msg":`)
+		writeJSON(&b, msg)
+		for _, f := range l.fields {
+			b.WriteByte(',')
+			writeJSON(&b, f.key)
+			b.WriteByte(':')
+			writeJSON(&b, jsonValue(f.value))
+		}
+		b.WriteString("}\n")
+	default:
+		b.WriteString(now.Format(time.RFC3339))
+		b.WriteByte(' ')
+		b.WriteString(strings.ToUpper(level.String()))
+		b.WriteByte(' ')
+		if l.subsystem != "" {
+			b.WriteString(l.subsystem)
+			b.WriteString(": ")
+		}
+		b.WriteString(msg)
+		for _, f := range l.fields {
+			b.WriteByte(' ')
+			b.WriteString(f.key)
+			b.WriteByte('=')
+			b.WriteString(textValue(f.value))
+		}
+		b.WriteByte('\n')
+	}
+
+	l.out.mu.Lock()
+	defer l.out.mu.Unlock()
+	l.out.w.Write(b.Bytes())
+}
+
+func writeJSON(b *bytes.Buffer, v interface{}) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		buf.Reset()
+		enc.Encode(fmt.Sprint(v))
+	}
+	b.Write(bytes.TrimSuffix(buf.Bytes(), []byte("\n")))
+}
+
+// jsonValue returns errors and values with a String method as strings, and
+// anything else as is.
+func jsonValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case error:
+		return v.Error()
+	case fmt.Stringer:
+		return v.String()
+	default:
+		return v
+	}
+}
+
+// textValue formats a field's value, quoting it if it would be ambiguous.
+func textValue(v interface{}) string {
+	s := fmt.Sprint(v)
+	if s == "" || strings.ContainsAny(s, " \"=\t\r\n") {
+		return strconv.Quote(s)
+	}
+	return s
+}
+
+// Writer returns a writer that logs each line written to it at level, for
+// packages logging with the log package.
+func (l *Logger) Writer(level Level) io.Writer {
+	return writerFunc(func(p []byte) (int, error) {
+		for _, line := range strings.Split(
+			strings.TrimRight(string(p), "\n"), "\n") {
+			l.logf(level, "%s", line)
+		}
+		return len(p), nil
+	})
+}
+
+type writerFunc func(p []byte) (int, error)
+
+func (f writerFunc) Write(p []byte) (int, error) {
+	return f(p)
+}
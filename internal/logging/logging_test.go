@@ -0,0 +1,69 @@
+package logging
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/diamondburned/arikawa/discord"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestLogger(b *bytes.Buffer, opts Options) *Logger {
+	l := New(b, opts)
+	l.out.now = func() time.Time {
+		return time.Date(2020, 7, 1, 12, 0, 0, 0, time.UTC)
+	}
+	return l
+}
+
+func TestText(t *testing.T) {
+	var b bytes.Buffer
+	l := newTestLogger(&b, Options{Format: Text, Level: Info})
+
+	l.Subsystem("client").With("addr", "127.0.0.1:1234",
+		"user", discord.Snowflake(80351110224678912)).
+		Infof("joined %s", "#general")
+	l.Subsystem("server").With("error", errors.New("no such host")).
+		Warnf("client disconnected")
+	l.Debugf("not shown")
+
+	assert.Equal(t, "2020-07-01T12:00:00Z INFO client: joined #general "+
+		"addr=127.0.0.1:1234 user=80351110224678912\n"+
+		"2020-07-01T12:00:00Z WARN server: client disconnected "+
+		`error="no such host"`+"\n", b.String())
+}
+
+func TestJSON(t *testing.T) {
+	var b bytes.Buffer
+	l := newTestLogger(&b, Options{Format: JSON, Level: Info})
+
+	l.Subsystem("client").With("user", discord.Snowflake(1), "n", 2).
+		Errorf("failed <- x")
+
+	assert.Equal(t, `{"time":"2020-07-01T12:00:00Z","level":"error",`+
+		`"subsystem":"client","msg":"failed <- x","user":"1","n":2}`+"\n",
+		b.String())
+}
+
+func TestLevels(t *testing.T) {
+	var b bytes.Buffer
+	l := newTestLogger(&b, Options{
+		Level:  Warn,
+		Levels: map[string]Level{"irc": Debug, "discord": Off},
+	})
+
+	assert.True(t, l.Subsystem("irc").Enabled(Debug))
+	assert.False(t, l.Subsystem("client").Enabled(Info))
+	assert.True(t, l.Subsystem("client").Enabled(Warn))
+	assert.False(t, l.Subsystem("discord").Enabled(Error))
+
+	l.Subsystem("discord").Errorf("hidden")
+	assert.Empty(t, b.String())
+
+	l.Subsystem("discord").Writer(Warn)
+	l.Subsystem("client").Writer(Warn).Write([]byte("one\ntwo\n"))
+	assert.Equal(t, "2020-07-01T12:00:00Z WARN client: one\n"+
+		"2020-07-01T12:00:00Z WARN client: two\n", b.String())
+}
@@ -0,0 +1,279 @@
+// Package metrics keeps counters and gauges describing the bridge, and
+// serves them over HTTP in the Prometheus text format.
+package metrics
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// The metrics updated across ircdiscord.
+var (
+	MessagesRelayed = NewCounterVec("ircdiscord_messages_relayed_total",
+		"Messages relayed between IRC and Discord.", "direction")
+	RenderErrors = NewCounter("ircdiscord_render_errors_total",
+		"Discord messages that failed to render for IRC.")
+	DiscordRequestDuration = NewHistogram(
+		"ircdiscord_discord_request_duration_seconds",
+		"Latency of requests to the Discord REST API.",
+		[]float64{.05, .1, .25, .5, 1, 2.5, 5, 10})
+	DiscordRateLimited = NewCounter("ircdiscord_discord_rate_limited_total",
+		"Requests to the Discord REST API answered with 429 Too Many "+
+			"Requests.")
+	GatewayReconnects = NewCounter("ircdiscord_gateway_reconnects_total",
+		"Times a Discord gateway connection was resumed or reopened.")
+)
+
+// Directions of relayed messages.
+const (
+	ToDiscord = "irc_to_discord"
+	ToIRC     = "discord_to_irc"
+)
+
+// Collector writes metrics when they are scraped.
+type Collector interface {
+	Collect(w *Writer)
+}
+
+var (
+	mu         sync.Mutex
+	collectors []Collector
+)
+
+// Register adds a collector to those written by Handler.
+func Register(c Collector) {
+	mu.Lock()
+	defer mu.Unlock()
+	collectors = append(collectors, c)
+}
+
+// Handler returns a handler serving the registered metrics.
+func Handler() http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		cs := append([]Collector(nil), collectors...)
+		mu.Unlock()
+
+		var w Writer
+		for _, c := range cs {
+			c.Collect(&w)
+		}
+
+		rw.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		rw.Write(w.buf.Bytes())
+	})
+}
+
+// Sample is one value of a metric, with labels given as alternating names
+// and values.
+type Sample struct {
+	Labels []string
+	Value  float64
+}
+
+// Writer writes metrics in the Prometheus text format.
+type Writer struct {
+	buf bytes.Buffer
+}
+
+// Gauge writes a gauge.
+func (w *Writer) Gauge(name, help string, samples ...Sample) {
+	w.write(name, help, "gauge", samples)
+}
+
+// Counter writes a counter.
+func (w *Writer) Counter(name, help string, samples ...Sample) {
+	w.write(name, help, "counter", samples)
+}
+
+func (w *Writer) write(name, help, typ string, samples []Sample) {
+	fmt.Fprintf(&w.buf, "# HELP %s %s\n", name, escapeHelp(help))
+	fmt.Fprintf(&w.buf, "# TYPE %s %s\n", name, typ)
+	for _, s := range samples {
+		w.sample(name, s.Labels, s.Value)
+	}
+}
+
+func (w *Writer) sample(name string, labels []string, value float64) {
+	w.buf.WriteString(name)
+	if len(labels) > 0 {
+		w.buf.WriteByte('{')
+		for i := 0; i+1 < len(labels); i += 2 {
+			if i > 0 {
+				w.buf.WriteByte(',')
+			}
+			fmt.Fprintf(&w.buf, "%s=\"%s\"",
+				labels[i], escapeLabel(labels[i+1]))
+		}
+		w.buf.WriteByte('}')
+	}
+	w.buf.WriteByte(' ')
+	w.buf.WriteString(formatValue(value))
+	w.buf.WriteByte('\n')
+}
+
+func formatValue(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	default:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	}
+}
+
+var (
+	helpEscaper  = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+	labelEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+)
+
+func escapeHelp(s string) string {
+	return helpEscaper.Replace(s)
+}
+
+func escapeLabel(s string) string {
+	return labelEscaper.Replace(s)
+}
+
+// Counter is a count that only goes up.
+type Counter struct {
+	name, help string
+	value      uint64
+}
+
+// NewCounter returns a registered counter.
+func NewCounter(name, help string) *Counter {
+	c := &Counter{name: name, help: help}
+	Register(c)
+	return c
+}
+
+// Inc adds one to the counter.
+func (c *Counter) Inc() {
+	atomic.AddUint64(&c.value, 1)
+}
+
+// Value returns the current count.
+func (c *Counter) Value() uint64 {
+	return atomic.LoadUint64(&c.value)
+}
+
+func (c *Counter) Collect(w *Writer) {
+	w.Counter(c.name, c.help, Sample{Value: float64(c.Value())})
+}
+
+// CounterVec is a set of counters told apart by the value of a label.
+type CounterVec struct {
+	name, help string
+	label      string
+
+	mu       sync.Mutex
+	counters map[string]*Counter
+}
+
+// NewCounterVec returns a registered set of counters.
+func NewCounterVec(name, help, label string) *CounterVec {
+	v := &CounterVec{
+		name:     name,
+		help:     help,
+		label:    label,
+		counters: make(map[string]*Counter),
+	}
+	Register(v)
+	return v
+}
+
+// With returns the counter for a value of the label.
+func (v *CounterVec) With(value string) *Counter {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	c, ok := v.counters[value]
+	if !ok {
+		c = &Counter{}
+		v.counters[value] = c
+	}
+	return c
+}
+
+func (v *CounterVec) Collect(w *Writer) {
+	v.mu.Lock()
+	values := make([]string, 0, len(v.counters))
+	for value := range v.counters {
+		values = append(values, value)
+	}
+	v.mu.Unlock()
+	sort.Strings(values)
+
+	samples := make([]Sample, len(values))
+	for i, value := range values {
+		samples[i] = Sample{
+			Labels: []string{v.label, value},
+			Value:  float64(v.With(value).Value()),
+		}
+	}
+	w.Counter(v.name, v.help, samples...)
+}
+
+// Histogram counts observations in buckets.
+type Histogram struct {
+	name, help string
+	bounds     []float64 // upper bounds of the buckets, ascending
+
+	mu     sync.Mutex
+	counts []uint64 // per bucket, with one more for +Inf
+	sum    float64
+}
+
+// NewHistogram returns a registered histogram with buckets up to bounds.
+func NewHistogram(name, help string, bounds []float64) *Histogram {
+	h := &Histogram{
+		name:   name,
+		help:   help,
+		bounds: bounds,
+		counts: make([]uint64, len(bounds)+1),
+	}
+	Register(h)
+	return h
+}
+
+// Observe adds a value to the histogram.
+func (h *Histogram) Observe(v float64) {
+	i := sort.SearchFloat64s(h.bounds, v)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.counts[i]++
+	h.sum += v
+}
+
+func (h *Histogram) Collect(w *Writer) {
+	h.mu.Lock()
+	counts := append([]uint64(nil), h.counts...)
+	sum := h.sum
+	h.mu.Unlock()
+
+	fmt.Fprintf(&w.buf, "# HELP %s %s\n", h.name, escapeHelp(h.help))
+	fmt.Fprintf(&w.buf, "# TYPE %s histogram\n", h.name)
+
+	var total uint64
+	for i, count := range counts {
+		total += count
+		bound := math.Inf(1)
+		if i < len(h.bounds) {
+			bound = h.bounds[i]
+		}
+		w.sample(h.name+"_bucket",
+			[]string{"le", formatValue(bound)}, float64(total))
+	}
+	w.sample(h.name+"_sum", nil, sum)
+	w.sample(h.name+"_count", nil, float64(total))
+}
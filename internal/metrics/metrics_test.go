@@ -0,0 +1,45 @@
+package metrics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriter(t *testing.T) {
+	var w Writer
+
+	v := &CounterVec{name: "relayed_total", help: "Messages\nrelayed.",
+		label: "direction", counters: make(map[string]*Counter)}
+	v.With("up").Inc()
+	v.With("down").Inc()
+	v.With("up").Inc()
+	v.Collect(&w)
+
+	w.Gauge("clients", "Clients.",
+		Sample{Labels: []string{"addr", `"odd"`}, Value: 2})
+
+	h := &Histogram{name: "latency_seconds", help: "Latency.",
+		bounds: []float64{.1, 1}, counts: make([]uint64, 3)}
+	h.Observe(.05)
+	h.Observe(.1)
+	h.Observe(.5)
+	h.Observe(3)
+	h.Collect(&w)
+
+	assert.Equal(t, `# HELP relayed_total Messages\nrelayed.
+# TYPE relayed_total counter
+relayed_total{direction="down"} 1
+relayed_total{direction="up"} 2
+# HELP clients Clients.
+# TYPE clients gauge
+clients{addr="\"odd\""} 2
+# HELP latency_seconds Latency.
+# TYPE latency_seconds histogram
+latency_seconds_bucket{le="0.1"} 2
+latency_seconds_bucket{le="1"} 3
+latency_seconds_bucket{le="+Inf"} 4
+latency_seconds_sum 3.65
+latency_seconds_count 4
+`, w.buf.String())
+}
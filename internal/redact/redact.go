@@ -0,0 +1,96 @@
+// Package redact masks secrets, such as Discord tokens and IRC passwords, in
+// log output.
+package redact
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// Mask replaces a secret.
+const Mask = "[redacted]"
+
+// tokenRegex matches Discord tokens: a user ID, a timestamp and an HMAC in
+// base64, or an MFA token.
+var tokenRegex = regexp.MustCompile(
+	`mfa\.[\w-]{20,}|[\w-]{18,}\.[\w-]{6,7}\.[\w-]{27,}`)
+
+// String masks the Discord tokens in s.
+func String(s string) string {
+	return tokenRegex.ReplaceAllString(s, Mask)
+}
+
+// Token returns a name for a token that can be logged, so log lines about the
+// same token can be matched up.
+func Token(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return "token#" + hex.EncodeToString(sum[:4])
+}
+
+// authenticateParams are AUTHENTICATE parameters that aren't secret.
+var authenticateParams = map[string]bool{
+	"+":        true,
+	"*":        true,
+	"PLAIN":    true,
+	"EXTERNAL": true,
+}
+
+// IRCLine masks the secrets in a raw IRC line: the parameters of PASS and of
+// AUTHENTICATE responses, and any Discord tokens.
+func IRCLine(line string) string {
+	// skip tags and prefix to find the command
+	rest := line
+	offset := 0
+	for strings.HasPrefix(rest, "@") || strings.HasPrefix(rest, ":") {
+		i := strings.IndexByte(rest, ' ')
+		if i < 0 {
+			return String(line)
+		}
+		offset += i + 1
+		rest = rest[i+1:]
+	}
+	for strings.HasPrefix(rest, " ") {
+		offset++
+		rest = rest[1:]
+	}
+
+	i := strings.IndexByte(rest, ' ')
+	if i < 0 {
+		return String(line)
+	}
+	command := strings.ToUpper(rest[:i])
+	params := strings.TrimPrefix(rest[i+1:], ":")
+
+	switch command {
+	case "PASS":
+	case "AUTHENTICATE":
+		if authenticateParams[strings.ToUpper(params)] {
+			return line
+		}
+	default:
+		return String(line)
+	}
+
+	return line[:offset] + rest[:i] + " " + Mask
+}
+
+// Writer masks the Discord tokens in everything written to it. Each write
+// must hold whole lines, as the log package makes them.
+type Writer struct {
+	w io.Writer
+}
+
+// NewWriter returns a Writer writing to w.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: w}
+}
+
+func (w *Writer) Write(p []byte) (int, error) {
+	if _, err := io.WriteString(w.w, String(string(p))); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
@@ -0,0 +1,53 @@
+package redact
+
+import (
+	"bytes"
+	"log"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// not a real token, but shaped like one
+const token = "NzkyNzE1NDU0MTk2MDg4ODQy.X-hvzA.Ovy4MCQywSkoMRRclStW4xAYK7I"
+
+func TestIRCLine(t *testing.T) {
+	for line, want := range map[string]string{
+		"PASS " + token:                          "PASS [redacted]",
+		"PASS :" + token + ":1234":               "PASS [redacted]",
+		"pass hunter2":                           "pass [redacted]",
+		"@time=x :nick PASS hunter2":             "@time=x :nick PASS [redacted]",
+		"AUTHENTICATE PLAIN":                     "AUTHENTICATE PLAIN",
+		"AUTHENTICATE +":                         "AUTHENTICATE +",
+		"AUTHENTICATE *":                         "AUTHENTICATE *",
+		"AUTHENTICATE AGFsaWNlAGh1bnRlcjI=":      "AUTHENTICATE [redacted]",
+		"PRIVMSG #general :my token is " + token: "PRIVMSG #general :my token is [redacted]",
+		"PRIVMSG #general :hello":                "PRIVMSG #general :hello",
+		"NICK alice":                             "NICK alice",
+		"PING":                                   "PING",
+	} {
+		assert.Equal(t, want, IRCLine(line), line)
+	}
+}
+
+func TestString(t *testing.T) {
+	assert.Equal(t, "Authorization: [redacted]",
+		String("Authorization: "+token))
+	assert.Equal(t, "token [redacted]",
+		String("token mfa.VkO_2G4Qv3T--NO--lWetW_tjND--TOKEN--QFTm6YGtzq9PH"))
+	assert.Equal(t, "message 792715454196088842 in #general",
+		String("message 792715454196088842 in #general"))
+}
+
+func TestToken(t *testing.T) {
+	assert.Equal(t, Token(token), Token(token))
+	assert.NotEqual(t, Token(token), Token("other"))
+	assert.NotContains(t, Token(token), token[:8])
+}
+
+func TestWriter(t *testing.T) {
+	var b bytes.Buffer
+	logger := log.New(NewWriter(&b), "", 0)
+	logger.Printf("requested session for token %s", token)
+	assert.Equal(t, "requested session for token [redacted]\n", b.String())
+}
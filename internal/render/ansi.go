@@ -0,0 +1,229 @@
+package render
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ansiColors maps the 16 ANSI colors to the mIRC palette.
+var ansiColors = [16]Color{
+	1, 5, 3, 7, 2, 6, 10, 15, // normal
+	14, 4, 9, 8, 12, 13, 11, 0, // bright
+}
+
+// defaultColor is the mIRC code for the client's default color, used when
+// only a background is set.
+const defaultColor Color = 99
+
+// sgrState is the text formatting set by ANSI SGR sequences.
+type sgrState struct {
+	bold, italic, underline, reverse bool
+	fg, bg                           Color
+}
+
+var plainSGR = sgrState{fg: NoColor, bg: NoColor}
+
+// highlightANSI converts the SGR escape sequences in code to IRC formatting,
+// and strips all other escape sequences.
+func highlightANSI(opts *Options, code []byte) string {
+	var s strings.Builder
+	state := plainSGR
+	src := string(code)
+
+	for len(src) > 0 {
+		i := strings.IndexAny(src, "\x1b\n")
+		if i == -1 {
+			s.WriteString(src)
+			break
+		}
+		s.WriteString(src[:i])
+		src = src[i:]
+
+		if src[0] == '\n' {
+			// formatting ends with the line on IRC, but not in ANSI
+			s.WriteString("\n")
+			writeSGRTransition(&s, opts, plainSGR, state)
+			src = src[1:]
+			continue
+		}
+
+		params, final, n := parseEscape(src)
+		src = src[n:]
+		if final != 'm' {
+			continue
+		}
+
+		next := applySGR(state, params)
+		writeSGRTransition(&s, opts, state, next)
+		state = next
+	}
+
+	return s.String()
+}
+
+// parseEscape parses the escape sequence at the start of s, returning its
+// parameters, its final byte and its length. Sequences other than CSI
+// sequences have a final byte of zero.
+func parseEscape(s string) (params string, final byte, n int) {
+	if len(s) < 2 || s[1] != '[' {
+		// a lone ESC, or a two-byte escape
+		if len(s) >= 2 {
+			return "", 0, 2
+		}
+		return "", 0, 1
+	}
+
+	for i := 2; i < len(s); i++ {
+		if s[i] >= 0x40 && s[i] <= 0x7E {
+			return s[2:i], s[i], i + 1
+		}
+		if s[i] == '\n' {
+			// never swallow newlines into an unterminated sequence
+			return "", 0, i
+		}
+	}
+
+	return "", 0, len(s)
+}
+
+// applySGR returns state with the SGR parameters params applied.
+func applySGR(state sgrState, params string) sgrState {
+	codes := []int{}
+	for _, param := range strings.Split(params, ";") {
+		code, err := strconv.Atoi(param)
+		if err != nil {
+			// empty parameters mean zero, anything else is unsupported
+			if param != "" {
+				return state
+			}
+			code = 0
+		}
+		codes = append(codes, code)
+	}
+
+	for i := 0; i < len(codes); i++ {
+		switch code := codes[i]; {
+		case code == 0:
+			state = plainSGR
+		case code == 1:
+			state.bold = true
+		case code == 3:
+			state.italic = true
+		case code == 4:
+			state.underline = true
+		case code == 7:
+			state.reverse = true
+		case code == 22:
+			state.bold = false
+		case code == 23:
+			state.italic = false
+		case code == 24:
+			state.underline = false
+		case code == 27:
+			state.reverse = false
+		case code >= 30 && code <= 37:
+			state.fg = ansiColors[code-30]
+		case code >= 90 && code <= 97:
+			state.fg = ansiColors[code-90+8]
+		case code == 39:
+			state.fg = NoColor
+		case code >= 40 && code <= 47:
+			state.bg = ansiColors[code-40]
+		case code >= 100 && code <= 107:
+			state.bg = ansiColors[code-100+8]
+		case code == 49:
+			state.bg = NoColor
+		case code == 38 || code == 48:
+			c, n := extendedColor(codes[i+1:])
+			i += n
+			if c == NoColor {
+				continue
+			}
+			if code == 38 {
+				state.fg = c
+			} else {
+				state.bg = c
+			}
+		}
+	}
+
+	return state
+}
+
+// extendedColor parses the arguments of a 38 or 48 SGR code, returning the
+// color and the number of arguments consumed.
+func extendedColor(args []int) (Color, int) {
+	switch {
+	case len(args) >= 2 && args[0] == 5:
+		n := args[1]
+		switch {
+		case n < 0 || n > 255:
+			return NoColor, 2
+		case n < 16:
+			return ansiColors[n], 2
+		case n < 232:
+			n -= 16
+			level := func(v int) uint32 {
+				if v == 0 {
+					return 0
+				}
+				return uint32(55 + v*40)
+			}
+			return RGB(level(n/36)<<16 | level(n/6%6)<<8 | level(n%6)), 2
+		default:
+			gray := uint32(8 + (n-232)*10)
+			return RGB(gray<<16 | gray<<8 | gray), 2
+		}
+	case len(args) >= 4 && args[0] == 2:
+		r, g, b := args[1]&0xFF, args[2]&0xFF, args[3]&0xFF
+		return RGB(uint32(r)<<16 | uint32(g)<<8 | uint32(b)), 4
+	default:
+		// malformed, ignore the rest of the sequence
+		return NoColor, len(args)
+	}
+}
+
+// writeSGRTransition writes the IRC formatting codes that change the
+// formatting from the state from to the state to.
+func writeSGRTransition(s *strings.Builder, opts *Options, from, to sgrState) {
+	if from.bold != to.bold {
+		s.WriteByte(0x02)
+	}
+	if from.italic != to.italic {
+		s.WriteByte(0x1D)
+	}
+	if from.underline != to.underline {
+		s.WriteByte(0x1F)
+	}
+	if from.reverse != to.reverse {
+		s.WriteByte(0x16)
+	}
+
+	if from.fg == to.fg && from.bg == to.bg {
+		return
+	}
+
+	if from.fg != NoColor || from.bg != NoColor {
+		fg, bg := from.colors()
+		s.WriteString(opts.off(fg))
+		if opts.off(bg) != opts.off(fg) {
+			s.WriteString(opts.off(bg))
+		}
+	}
+
+	if to.fg != NoColor || to.bg != NoColor {
+		s.WriteString(opts.fgbg(to.colors()))
+		// keep digits and commas in the text out of the color code
+		s.WriteString("\x02\x02")
+	}
+}
+
+// colors returns the foreground and background colors to write for state.
+// IRC can't set a background color alone, so the default foreground color
+// is used then.
+func (state sgrState) colors() (fg, bg Color) {
+	if state.fg == NoColor && state.bg != NoColor {
+		return defaultColor, state.bg
+	}
+	return state.fg, state.bg
+}
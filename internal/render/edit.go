@@ -0,0 +1,253 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/diamondburned/arikawa/discord"
+)
+
+// EditMode selects how edited messages are shown.
+type EditMode int
+
+const (
+	// DiffEdits shows an edit as the words that changed, with a few words
+	// of context.
+	DiffEdits EditMode = iota
+	// TaggedEdits resends the whole message with a +draft/edit tag naming
+	// the original's msgid, for clients that can replace it. Clients
+	// without message-tags get a diff instead.
+	TaggedEdits
+)
+
+// ParseEditMode parses the name of an EditMode, either "diff" or "tag".
+func ParseEditMode(name string) (EditMode, error) {
+	switch name {
+	case "diff":
+		return DiffEdits, nil
+	case "tag":
+		return TaggedEdits, nil
+	default:
+		return 0, fmt.Errorf("unknown edit mode %s", name)
+	}
+}
+
+// diffContext is the number of unchanged words kept on each side of a change.
+const diffContext = 3
+
+// Edit renders the change from old to the current content of m as a single
+// line of words that were removed and added.
+func Edit(guildID discord.Snowflake, sess Session, old string,
+	m *discord.Message, opts *Options) string {
+	// render both sides without the edited marker, then diff the text
+	unedited := *m
+	unedited.EditedTimestamp = discord.Timestamp{}
+	// spoilers hidden by color would show once the colors are stripped
+	plain := *opts
+	if plain.Spoilers == ColorSpoilers {
+		plain.Spoilers = HiddenSpoilers
+	}
+	before := strings.Fields(StripFormatting(
+		Content(guildID, sess, []byte(old), &unedited, &plain)))
+	after := strings.Fields(StripFormatting(
+		Content(guildID, sess, []byte(m.Content), &unedited, &plain)))
+
+	ops, ok := diffWords(before, after)
+	if !ok {
+		return EditedLine(guildID, sess, m, opts)
+	}
+
+	theme := opts.Theme
+	var s strings.Builder
+	fmt.Fprintf(&s, "\x1D%s\x1D", opts.paint(theme.Edited, "(edited)"))
+
+	for i := 0; i < len(ops); {
+		j := i
+		for j < len(ops) && ops[j].kind == ops[i].kind {
+			j++
+		}
+		run := ops[i:j]
+
+		var words []string
+		for _, op := range run {
+			words = append(words, op.word)
+		}
+
+		switch run[0].kind {
+		case diffEqual:
+			// context follows the previous change and precedes the next
+			head, tail := diffContext, diffContext
+			if i == 0 {
+				head = 0
+			}
+			if j == len(ops) {
+				tail = 0
+			}
+			if len(words) > head+tail {
+				collapsed := append([]string{}, words[:head]...)
+				collapsed = append(collapsed, "…")
+				words = append(collapsed, words[len(words)-tail:]...)
+			}
+			s.WriteString(" " + strings.Join(words, " "))
+		case diffRemoved:
+			s.WriteString(" " + opts.paint(theme.DiffRemoved,
+				"\x1E"+strings.Join(words, " ")+"\x1E"))
+		case diffAdded:
+			s.WriteString(" " + opts.paint(theme.DiffAdded,
+				strings.Join(words, " ")))
+		}
+
+		i = j
+	}
+
+	return s.String()
+}
+
+// EditedLine renders the current content of an edited message on a single
+// line after the edited marker, for when what it used to say isn't known.
+func EditedLine(guildID discord.Snowflake, sess Session, m *discord.Message,
+	opts *Options) string {
+	unedited := *m
+	unedited.EditedTimestamp = discord.Timestamp{}
+	content := Content(guildID, sess, []byte(m.Content), &unedited, opts)
+
+	return fmt.Sprintf("\x1D%s\x1D %s",
+		opts.paint(opts.Theme.Edited, "(edited)"),
+		strings.Join(strings.Fields(content), " "))
+}
+
+type diffKind int
+
+const (
+	diffEqual diffKind = iota
+	diffRemoved
+	diffAdded
+)
+
+type diffOp struct {
+	kind diffKind
+	word string
+}
+
+// maxDiffCells bounds the size of the table diffWords fills, which takes
+// memory and time proportional to the product of the lengths of the changed
+// parts.
+const maxDiffCells = 100000
+
+// diffWords returns the edit script turning a into b, using their longest
+// common subsequence. Removals come before additions in each change. It
+// returns false if the changed parts are too long to diff.
+func diffWords(a, b []string) ([]diffOp, bool) {
+	// words before and after the change need no table
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix &&
+		a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	var ops []diffOp
+	for _, word := range a[:prefix] {
+		ops = append(ops, diffOp{diffEqual, word})
+	}
+
+	changed, ok := diffChanged(a[prefix:len(a)-suffix],
+		b[prefix:len(b)-suffix])
+	if !ok {
+		return nil, false
+	}
+	ops = append(ops, changed...)
+
+	for _, word := range a[len(a)-suffix:] {
+		ops = append(ops, diffOp{diffEqual, word})
+	}
+	return ops, true
+}
+
+// diffChanged is diffWords without the common prefix and suffix.
+func diffChanged(a, b []string) ([]diffOp, bool) {
+	if (len(a)+1)*(len(b)+1) > maxDiffCells {
+		return nil, false
+	}
+
+	// lcs[i][j] is the length of the longest common subsequence of a[i:]
+	// and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var ops []diffOp
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			ops = append(ops, diffOp{diffEqual, a[i]})
+			i++
+			j++
+		case i < len(a) && (j == len(b) || lcs[i+1][j] >= lcs[i][j+1]):
+			ops = append(ops, diffOp{diffRemoved, a[i]})
+			i++
+		default:
+			ops = append(ops, diffOp{diffAdded, b[j]})
+			j++
+		}
+	}
+	return ops, true
+}
+
+// StripFormatting removes IRC formatting codes from s.
+func StripFormatting(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\x02', '\x0F', '\x11', '\x16', '\x1D', '\x1E', '\x1F':
+		case '\x03':
+			i += colorCodeLength(s[i+1:], isDigit, 2)
+		case '\x04':
+			i += colorCodeLength(s[i+1:], isHexDigit, 6)
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return b.String()
+}
+
+// colorCodeLength returns the length of the foreground and optional
+// background color after a color code, each up to n digits long.
+func colorCodeLength(s string, digit func(byte) bool, n int) int {
+	fg := 0
+	for fg < n && fg < len(s) && digit(s[fg]) {
+		fg++
+	}
+	if fg == 0 || fg+1 >= len(s) || s[fg] != ',' || !digit(s[fg+1]) {
+		return fg
+	}
+	bg := 0
+	for bg < n && fg+1+bg < len(s) && digit(s[fg+1+bg]) {
+		bg++
+	}
+	return fg + 1 + bg
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isHexDigit(c byte) bool {
+	return isDigit(c) || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F'
+}
@@ -0,0 +1,53 @@
+package render
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/diamondburned/arikawa/discord"
+	"github.com/diamondburned/arikawa/state"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffWords(t *testing.T) {
+	ops, ok := diffWords(strings.Fields("the quick brown fox"),
+		strings.Fields("the slow brown fox jumps"))
+	assert.True(t, ok)
+	assert.Equal(t, []diffOp{
+		{diffEqual, "the"},
+		{diffRemoved, "quick"},
+		{diffAdded, "slow"},
+		{diffEqual, "brown"},
+		{diffEqual, "fox"},
+		{diffAdded, "jumps"},
+	}, ops)
+
+	// long messages with a small change are still diffed
+	long := strings.Fields(strings.Repeat("word ", 2000))
+	changed := append(append([]string{}, long[:1000]...), "new")
+	changed = append(changed, long[1000:]...)
+	ops, ok = diffWords(long, changed)
+	assert.True(t, ok)
+	assert.Len(t, ops, 2001)
+
+	// but not ones rewritten throughout
+	rewritten := strings.Fields(strings.Repeat("other ", 2000))
+	_, ok = diffWords(long, rewritten)
+	assert.False(t, ok)
+}
+
+func TestEditSpoiler(t *testing.T) {
+	sess := &fakeSession{store: state.NewDefaultStore(nil)}
+	m := &discord.Message{
+		ID:              5,
+		Content:         "the butler ||did it||",
+		EditedTimestamp: discord.NewTimestamp(time.Now()),
+	}
+
+	out := StripFormatting(Edit(0, sess, "the maid ||did it||", m,
+		DefaultOptions()))
+	assert.Contains(t, out, "butler")
+	assert.Contains(t, out, "[spoiler in message")
+	assert.NotContains(t, out, "did")
+}
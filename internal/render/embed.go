@@ -0,0 +1,200 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/diamondburned/arikawa/discord"
+)
+
+// maxInlineFields is the number of inline fields Discord shows side by side.
+const maxInlineFields = 3
+
+// embed renders e as lines prefixed with a bar in the embed's color.
+func embed(guildID discord.Snowflake, sess Session,
+	m *discord.Message, e *discord.Embed, opts *Options) string {
+	theme := opts.Theme
+	var es strings.Builder
+
+	// content renders a piece of embed markdown onto a single line
+	content := func(source string) string {
+		return strings.Trim(Content(guildID, sess, []byte(source), m, opts), "\n")
+	}
+
+	label := func(name string) string {
+		return fmt.Sprintf("\x1D%s:\x1D", name)
+	}
+
+	link := func(url string) string {
+		return opts.paint(theme.Link, url)
+	}
+
+	// formatting ends with the line, so each line is formatted on its own
+	bold := func(s string, c Color) string {
+		lines := strings.Split(lineBreaks.Replace(s), "\n")
+		for i, line := range lines {
+			lines[i] = "\x02" + opts.paint(c, line) + "\x02"
+		}
+		return strings.Join(lines, "\n")
+	}
+
+	if e.Provider != nil && e.Provider.Name != "" {
+		es.WriteString(e.Provider.Name)
+		if e.Provider.URL != "" {
+			es.WriteString(" " + link(e.Provider.URL))
+		}
+		es.WriteString("\n")
+	}
+
+	if e.Author != nil && e.Author.Name != "" {
+		es.WriteString(bold(e.Author.Name, NoColor))
+		if e.Author.URL != "" {
+			es.WriteString(" " + link(e.Author.URL))
+		}
+		es.WriteString("\n")
+	}
+
+	if e.Title != "" {
+		es.WriteString(bold(e.Title, theme.EmbedTitle))
+		if e.URL != "" {
+			es.WriteString(" " + link(e.URL))
+		}
+		es.WriteString("\n")
+	}
+
+	if e.Description != "" {
+		es.WriteString(Content(guildID, sess, []byte(e.Description), m, opts))
+		es.WriteString("\n")
+	}
+
+	// consecutive inline fields are grouped onto shared lines
+	var inline []string
+	flush := func() {
+		if len(inline) > 0 {
+			es.WriteString(strings.Join(inline, " │ "))
+			es.WriteString("\n")
+			inline = inline[:0]
+		}
+	}
+
+	for _, f := range e.Fields {
+		value := content(f.Value)
+		if f.Inline && !strings.Contains(value, "\n") {
+			inline = append(inline, label(f.Name)+" "+value)
+			if len(inline) == maxInlineFields {
+				flush()
+			}
+			continue
+		}
+
+		flush()
+		es.WriteString(label(f.Name))
+		es.WriteString("\n")
+		es.WriteString(value)
+		es.WriteString("\n")
+	}
+	flush()
+
+	if e.Image != nil && e.Image.URL != "" {
+		es.WriteString(label("image") + " " + link(e.Image.URL))
+		if e.Image.Width != 0 && e.Image.Height != 0 {
+			fmt.Fprintf(&es, " (%dx%d)", e.Image.Width, e.Image.Height)
+		}
+		es.WriteString("\n")
+	}
+
+	if e.Video != nil && e.Video.URL != "" {
+		es.WriteString(label("video") + " " + link(e.Video.URL))
+		if e.Video.Width != 0 && e.Video.Height != 0 {
+			fmt.Fprintf(&es, " (%dx%d)", e.Video.Width, e.Video.Height)
+		}
+		es.WriteString("\n")
+	} else if e.Thumbnail != nil && e.Thumbnail.URL != "" {
+		es.WriteString(label("thumbnail") + " " + link(e.Thumbnail.URL))
+		es.WriteString("\n")
+	}
+
+	var footer []string
+	if e.Footer != nil && e.Footer.Text != "" {
+		footer = append(footer, content(e.Footer.Text))
+	}
+	if e.Timestamp.Valid() {
+		footer = append(footer,
+			opts.formatTime(e.Timestamp.Time(), 'f', time.Now()))
+	}
+	if len(footer) > 0 {
+		fmt.Fprintf(&es, "\x1D%s\x1D\n", strings.Join(footer, " • "))
+	}
+
+	bar := embedBar(e, theme)
+
+	// titles and names can hold line breaks of any kind too, and each line
+	// needs its own bar
+	lines := strings.Split(
+		strings.Trim(lineBreaks.Replace(es.String()), "\n"), "\n")
+
+	var s strings.Builder
+	for i, line := range lines {
+		if i == 0 && line == "" {
+			continue
+		}
+		s.WriteString(opts.paint(bar, "▌"))
+		s.WriteString(line)
+		s.WriteString("\n")
+	}
+	return s.String()
+}
+
+// embedBar returns the color of the bar in front of each line of e.
+func embedBar(e *discord.Embed, theme *Theme) Color {
+	if e.Color != 0 {
+		return RGB(e.Color.Uint32())
+	}
+	return theme.EmbedBar
+}
+
+// IsLinkPreview returns whether e was generated by Discord to unfurl a link
+// in the content of m, as opposed to a rich embed sent by a bot or webhook.
+func IsLinkPreview(m *discord.Message, e *discord.Embed) bool {
+	return e.Type != discord.NormalEmbed && e.Type != "" &&
+		e.URL != "" && strings.Contains(m.Content, e.URL)
+}
+
+// Previews renders the link previews of m in full, regardless of the
+// preview mode in opts.
+func Previews(guildID discord.Snowflake, sess Session,
+	m *discord.Message, opts *Options) string {
+	var s strings.Builder
+	for _, e := range m.Embeds {
+		if IsLinkPreview(m, &e) {
+			s.WriteString(embed(guildID, sess, m, &e, opts))
+		}
+	}
+	return strings.Trim(s.String(), "\n")
+}
+
+// collapsedPreview renders a link preview on a single line.
+func collapsedPreview(e *discord.Embed, opts *Options) string {
+	theme := opts.Theme
+
+	title := e.Title
+	if title == "" {
+		title = e.URL
+	}
+
+	var s strings.Builder
+	fmt.Fprintf(&s, "%s\x1D[preview]\x1D ", opts.paint(embedBar(e, theme), "▌"))
+	if e.Provider != nil && e.Provider.Name != "" {
+		s.WriteString(singleLine(e.Provider.Name) + ": ")
+	}
+	s.WriteString(opts.paint(theme.EmbedTitle, singleLine(title)))
+	s.WriteString("\n")
+	return s.String()
+}
+
+// singleLine joins the lines of s with spaces.
+func singleLine(s string) string {
+	return strings.ReplaceAll(strings.Trim(lineBreaks.Replace(s), "\n"),
+		"\n", " ")
+}
@@ -0,0 +1,53 @@
+//go:build go1.18
+// +build go1.18
+
+package render
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/diamondburned/arikawa/discord"
+)
+
+func FuzzContent(f *testing.F) {
+	f.Add("**bold** *italic* __underline__ ~~strike~~ `code` ||spoiler||")
+	f.Add("> quote\n> > nested **bold\n```go\nfunc main() {}\n```")
+	f.Add("<@400> <@&300> <#200> <:blob:600> <t:1591012800:R> https://x.y")
+	f.Add("```diff\n+a\n-b\n@@ c\n```\n```ansi\n\x1b[1;31mred\x1b[0m\n```")
+	f.Add("[link](https://example.com) \\*escaped\\* ||a **b** `c`||")
+
+	guild := &discord.Guild{ID: 100, Roles: []discord.Role{
+		{ID: 300, Name: "Moderators", Color: 0x3498DB},
+	}}
+	sess, err := newFakeSession(&fixture{
+		Guild:    guild,
+		Channels: []discord.Channel{{ID: 200, GuildID: 100, Name: "general"}},
+		Members: []discord.Member{{
+			User: discord.User{ID: 400, Username: "alice"},
+		}},
+	})
+	if err != nil {
+		f.Fatal(err)
+	}
+	m := &discord.Message{ID: 500, ChannelID: 200, GuildID: 100}
+
+	f.Fuzz(func(t *testing.T, source string) {
+		for _, spoilers := range []SpoilerMode{ColorSpoilers, ROT13Spoilers,
+			HiddenSpoilers} {
+			opts := DefaultOptions()
+			opts.Spoilers = spoilers
+
+			out := Content(guild.ID, sess, []byte(source), m, opts)
+
+			if utf8.ValidString(source) && !utf8.ValidString(out) {
+				t.Errorf("invalid UTF-8 in %q", out)
+			}
+			// each line is sent as a separate IRC parameter
+			if strings.ContainsAny(out, "\r\x00") {
+				t.Errorf("CR or NUL in %q", out)
+			}
+		}
+	})
+}
@@ -0,0 +1,222 @@
+package render
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/sourcegraph/syntaxhighlight"
+)
+
+// highlighter renders the contents of a fenced code block.
+type highlighter func(opts *Options, code []byte) string
+
+var highlighters = map[string]highlighter{
+	"diff":    highlightDiff,
+	"patch":   highlightDiff,
+	"ansi":    highlightANSI,
+	"py":      pythonLexer.highlight,
+	"python":  pythonLexer.highlight,
+	"python3": pythonLexer.highlight,
+	"yaml":    yamlLexer.highlight,
+	"yml":     yamlLexer.highlight,
+	"sh":      shellLexer.highlight,
+	"bash":    shellLexer.highlight,
+	"zsh":     shellLexer.highlight,
+	"shell":   shellLexer.highlight,
+	"console": shellLexer.highlight,
+}
+
+// highlight returns code with syntax highlighting for language, the first
+// word of a fenced code block's info string. Unknown languages are run
+// through the generic scanner.
+func highlight(opts *Options, language string, code []byte) string {
+	if h, ok := highlighters[strings.ToLower(language)]; ok {
+		return h(opts, code)
+	}
+
+	scanner := syntaxhighlight.NewScanner(code)
+	var highlighted strings.Builder
+	syntaxhighlight.Print(scanner, &highlighted, ircPrinter{opts})
+	return highlighted.String()
+}
+
+func highlightDiff(opts *Options, code []byte) string {
+	theme := opts.Theme
+	var s strings.Builder
+	for _, line := range strings.Split(string(code), "\n") {
+		switch {
+		case strings.HasPrefix(line, "+++"),
+			strings.HasPrefix(line, "---"),
+			strings.HasPrefix(line, "diff "),
+			strings.HasPrefix(line, "index "):
+			s.WriteString("\x02" + line + "\x02")
+		case strings.HasPrefix(line, "@@"):
+			s.WriteString(opts.paint(theme.DiffHunk, line))
+		case strings.HasPrefix(line, "+"):
+			s.WriteString(opts.paint(theme.DiffAdded, line))
+		case strings.HasPrefix(line, "-"):
+			s.WriteString(opts.paint(theme.DiffRemoved, line))
+		default:
+			s.WriteString(line)
+		}
+		s.WriteString("\n")
+	}
+	return s.String()
+}
+
+// lexer is a small tokenizer for languages the generic scanner handles
+// badly, mostly those with # comments.
+type lexer struct {
+	comments  []string // line comment prefixes
+	quotes    string   // string delimiters
+	triple    bool     // tripled quotes start multi-line strings
+	variables bool     // $name and ${name} are variables
+	keys      bool     // a word followed by a colon is a mapping key
+	keywords  map[string]bool
+	builtins  map[string]bool
+	constants map[string]bool
+}
+
+func words(s string) map[string]bool {
+	m := make(map[string]bool)
+	for _, word := range strings.Fields(s) {
+		m[word] = true
+	}
+	return m
+}
+
+var pythonLexer = &lexer{
+	comments: []string{"#"},
+	quotes:   `"'`,
+	triple:   true,
+	keywords: words(`and as assert async await break class continue def del
+		elif else except finally for from global if import in is lambda
+		nonlocal not or pass raise return try while with yield match case`),
+	builtins: words(`abs all any bool bytes callable chr dict dir divmod
+		enumerate filter float format getattr hasattr hash hex id input int
+		isinstance issubclass iter len list map max min next object open ord
+		pow print property range repr reversed round set setattr slice sorted
+		staticmethod classmethod str sum super tuple type vars zip self cls`),
+	constants: words(`True False None NotImplemented Ellipsis`),
+}
+
+var yamlLexer = &lexer{
+	comments:  []string{"#"},
+	quotes:    `"'`,
+	keys:      true,
+	constants: words(`true false True False TRUE FALSE yes no on off null Null NULL`),
+}
+
+var shellLexer = &lexer{
+	comments:  []string{"#"},
+	quotes:    "\"'`",
+	variables: true,
+	keywords: words(`if then else elif fi for while until do done case esac
+		in function select time return break continue`),
+	builtins: words(`alias cd echo eval exec exit export local printf pwd read
+		readonly set shift source test trap type ulimit umask unset wait sudo`),
+}
+
+func (l *lexer) highlight(opts *Options, code []byte) string {
+	p := ircPrinter{opts}
+	src := string(code)
+	var s strings.Builder
+	for len(src) > 0 {
+		kind, n := l.next(src)
+		p.Print(&s, kind, src[:n])
+		src = src[n:]
+	}
+	return s.String()
+}
+
+// next returns the kind and length of the token at the start of src.
+func (l *lexer) next(src string) (syntaxhighlight.Kind, int) {
+	r, size := utf8.DecodeRuneInString(src)
+
+	switch {
+	case unicode.IsSpace(r):
+		return syntaxhighlight.Whitespace, spanOf(src, unicode.IsSpace)
+	case l.isComment(src):
+		if i := strings.IndexByte(src, '\n'); i != -1 {
+			return syntaxhighlight.Comment, i
+		}
+		return syntaxhighlight.Comment, len(src)
+	case l.triple && (strings.HasPrefix(src, `"""`) ||
+		strings.HasPrefix(src, `'''`)):
+		if i := strings.Index(src[3:], src[:3]); i != -1 {
+			return syntaxhighlight.String, i + 6
+		}
+		return syntaxhighlight.String, len(src)
+	case strings.ContainsRune(l.quotes, r):
+		return syntaxhighlight.String, quotedLength(src)
+	case l.variables && r == '$' && len(src) > 1:
+		if src[1] == '{' {
+			if i := strings.IndexByte(src, '}'); i != -1 {
+				return syntaxhighlight.Literal, i + 1
+			}
+		}
+		if n := spanOf(src[1:], isWord); n != 0 {
+			return syntaxhighlight.Literal, 1 + n
+		}
+		// special parameters like $? and $#
+		_, size := utf8.DecodeRuneInString(src[1:])
+		return syntaxhighlight.Literal, 1 + size
+	case unicode.IsDigit(r):
+		return syntaxhighlight.Decimal, spanOf(src, isWord)
+	case isWord(r):
+		n := spanOf(src, isWord)
+		word := src[:n]
+		switch {
+		case l.keywords[word]:
+			return syntaxhighlight.Keyword, n
+		case l.builtins[word]:
+			return syntaxhighlight.Type, n
+		case l.constants[word]:
+			return syntaxhighlight.Literal, n
+		case l.keys && strings.HasPrefix(src[n:], ":"):
+			return syntaxhighlight.Type, n
+		}
+		return syntaxhighlight.Plaintext, n
+	default:
+		return syntaxhighlight.Punctuation, size
+	}
+}
+
+func (l *lexer) isComment(src string) bool {
+	for _, prefix := range l.comments {
+		if strings.HasPrefix(src, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func isWord(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// spanOf returns the length of the prefix of s whose runes satisfy f.
+func spanOf(s string, f func(rune) bool) int {
+	if i := strings.IndexFunc(s, func(r rune) bool { return !f(r) }); i != -1 {
+		return i
+	}
+	return len(s)
+}
+
+// quotedLength returns the length of the string literal at the start of s,
+// including its quotes. Unterminated strings end at the end of the line.
+func quotedLength(s string) int {
+	quote := s[0]
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case quote:
+			return i + 1
+		case '\n':
+			return i
+		}
+	}
+	return len(s)
+}
@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"time"
 
 	"github.com/diamondburned/arikawa/discord"
+	"github.com/diamondburned/arikawa/state"
 	"github.com/diamondburned/ningen/md"
 	"github.com/sourcegraph/syntaxhighlight"
 	"github.com/tadeokondrak/ircdiscord/internal/color"
@@ -14,9 +16,179 @@ import (
 	"github.com/yuin/goldmark/ast"
 )
 
-func Content(guildID discord.Snowflake, sess *session.Session, source []byte, m *discord.Message) string {
-	parsed := md.ParseWithMessage(source, sess.Store, m, false)
+// Session is the Discord state that rendering reads from. It is implemented
+// by *session.Session.
+type Session interface {
+	// Cache returns the state received from Discord, which is looked up
+	// without making requests.
+	Cache() state.Store
+	ChannelName(guild, id discord.Snowflake) (string, error)
+	UserName(guild, id discord.Snowflake) (string, error)
+}
+
+// Options controls how Discord content is rendered for a client.
+type Options struct {
+	Theme        *Theme
+	Colors       ColorMode
+	LinkPreviews PreviewMode
+	Edits        EditMode
+	Spoilers     SpoilerMode
+	Location     *time.Location // for timestamps, UTC if nil
+	Locale       string         // a key of Locales
+	EmojiURLs    bool           // append image URLs to custom emoji
+}
+
+// ColorMode selects how colors are written to IRC.
+type ColorMode int
+
+const (
+	// PaletteColors maps every color to the 99-color mIRC palette.
+	PaletteColors ColorMode = iota
+	// HexColors writes exact colors with the \x04RRGGBB extension, for
+	// clients that support it.
+	HexColors
+)
+
+// ParseColorMode parses the name of a ColorMode, either "palette" or "hex".
+func ParseColorMode(name string) (ColorMode, error) {
+	switch name {
+	case "palette":
+		return PaletteColors, nil
+	case "hex":
+		return HexColors, nil
+	default:
+		return 0, fmt.Errorf("unknown color mode %s", name)
+	}
+}
+
+// PreviewMode selects how link preview embeds are shown.
+type PreviewMode int
+
+const (
+	// ShowPreviews renders link previews like any other embed.
+	ShowPreviews PreviewMode = iota
+	// CollapsePreviews renders each link preview on a single line.
+	CollapsePreviews
+	// HidePreviews leaves link previews out.
+	HidePreviews
+)
+
+// ParsePreviewMode parses the name of a PreviewMode, one of "show",
+// "collapse" or "hide".
+func ParsePreviewMode(name string) (PreviewMode, error) {
+	switch name {
+	case "show":
+		return ShowPreviews, nil
+	case "collapse":
+		return CollapsePreviews, nil
+	case "hide":
+		return HidePreviews, nil
+	default:
+		return 0, fmt.Errorf("unknown preview mode %s", name)
+	}
+}
+
+// DefaultOptions returns the options used when nothing else is configured.
+func DefaultOptions() *Options {
+	theme := DarkTheme
+	return &Options{Theme: &theme}
+}
+
+// fg returns the formatting code that sets c as the foreground color.
+func (o *Options) fg(c Color) string {
+	switch {
+	case c == NoColor:
+		return ""
+	case c.IsRGB() && o.Colors == HexColors:
+		return color.HexCode(c.Hex())
+	default:
+		return fmt.Sprintf("\x03%02d", c.Palette())
+	}
+}
+
+// fgbg returns the formatting code that sets fg and bg as the foreground and
+// background colors.
+func (o *Options) fgbg(fg, bg Color) string {
+	switch {
+	case fg == NoColor || bg == NoColor:
+		return o.fg(fg)
+	case fg.IsRGB() && bg.IsRGB() && o.Colors == HexColors:
+		return fmt.Sprintf("%s,%06X", color.HexCode(fg.Hex()), bg.Hex())
+	default:
+		return fmt.Sprintf("\x03%02d,%02d", fg.Palette(), bg.Palette())
+	}
+}
+
+// off returns the formatting code that ends a span started with fg(c). The
+// code is followed by two bold toggles, so digits in the text after it
+// aren't read as a new color.
+func (o *Options) off(c Color) string {
+	switch {
+	case c == NoColor:
+		return ""
+	case c.IsRGB() && o.Colors == HexColors:
+		return "\x04\x02\x02"
+	default:
+		return "\x03\x02\x02"
+	}
+}
+
+// paint returns s in the color c.
+func (o *Options) paint(c Color, s string) string {
+	return o.fg(c) + s + o.off(c)
+}
+
+// memberColor returns the color of the highest colored role of a guild
+// member, or fallback if it has none.
+func memberColor(guildID discord.Snowflake, sess Session,
+	user *discord.GuildUser, fallback Color) Color {
+	if !guildID.Valid() {
+		return fallback
+	}
+
+	guild, err := sess.Cache().Guild(guildID)
+	if err != nil {
+		return fallback
+	}
+
+	member := user.Member
+	if member == nil {
+		member, err = sess.Cache().Member(guildID, user.ID)
+		if err != nil {
+			return fallback
+		}
+	}
+
+	if c := discord.MemberColor(*guild, *member); c != 0 {
+		return RGB(c.Uint32())
+	}
+
+	return fallback
+}
+
+// lineBreaks turns every kind of line break into \n, which is where
+// messages are split into IRC lines, and drops NULs, which IRC can't carry.
+var lineBreaks = strings.NewReplacer("\r\n", "\n", "\r", "\n", "\x00", "")
+
+func Content(guildID discord.Snowflake, sess Session, source []byte, m *discord.Message, opts *Options) string {
+	theme := opts.Theme
+	parsed := md.ParseWithMessage(source, sess.Cache(), m, false)
 	var s strings.Builder
+	// relative timestamps are relative to the message they are in
+	now := time.Now()
+	if m != nil && m.Timestamp.Valid() {
+		now = m.Timestamp.Time()
+	}
+	inCode := false
+	inSpoiler := false
+	// spoiled encodes text inside a spoiler shown with ROT13, including
+	// URLs and names, which would give it away as much as the text.
+	spoiled := func(text string) string {
+		if inSpoiler && opts.Spoilers == ROT13Spoilers {
+			return rot13(text)
+		}
+		return text
+	}
 	var walker func(n ast.Node, enter bool) (ast.WalkStatus, error)
 	walker = func(n ast.Node, enter bool) (ast.WalkStatus, error) {
 		switch n := n.(type) {
@@ -25,14 +197,14 @@ func Content(guildID discord.Snowflake, sess *session.Session, source []byte, m
 		case *ast.Blockquote:
 			if enter {
 				for child := n.FirstChild(); child != nil; child = child.NextSibling() {
-					s.WriteString("\x0309>\x03 ")
+					s.WriteString(opts.paint(theme.Quote, ">") + " ")
 					ast.Walk(child, func(node ast.Node, enter bool) (ast.WalkStatus, error) {
-						// We only call when entering, since we don't want to trigger a
-						// hard new line after each paragraph.
-						if enter {
-							walker(node, true)
+						// We skip leaving paragraphs, since we don't want to trigger a
+						// hard new line after each one.
+						if !enter && node.Kind() == ast.KindParagraph {
+							return ast.WalkContinue, nil
 						}
-						return ast.WalkContinue, nil
+						return walker(node, enter)
 					})
 				}
 				return ast.WalkSkipChildren, nil
@@ -40,7 +212,7 @@ func Content(guildID discord.Snowflake, sess *session.Session, source []byte, m
 		case *ast.Paragraph:
 			if !enter {
 				if m != nil && m.EditedTimestamp.Valid() {
-					s.WriteString(" \x1D\x0311(edited)\x03\x1D")
+					fmt.Fprintf(&s, " \x1D%s\x1D", opts.paint(theme.Edited, "(edited)"))
 				}
 				s.WriteString("\n")
 			}
@@ -55,27 +227,27 @@ func Content(guildID discord.Snowflake, sess *session.Session, source []byte, m
 					content.Write(line.Value(source))
 
 				}
-				scanner := syntaxhighlight.NewScanner(content.Bytes())
-				var highlighted strings.Builder
-				syntaxhighlight.Print(scanner, &highlighted, ircPrinter{})
-				for i, line := range strings.Split(strings.Trim(highlighted.String(), "\n"), "\n") {
+				highlighted := highlight(opts,
+					string(n.Language(source)), content.Bytes())
+				for i, line := range strings.Split(strings.Trim(highlighted, "\n"), "\n") {
 					if i == 0 && line == "" {
 						continue
 					}
-					s.WriteString("\x0314>\x03 ")
+					s.WriteString(opts.paint(theme.CodeBlock, ">") + " ")
 					s.WriteString(line)
 					s.WriteString("\n")
 				}
 			}
 		case *ast.Link:
 			if enter {
-				fmt.Fprintf(&s, "\x0302[\x03")
+				s.WriteString(opts.paint(theme.Link, "["))
 			} else {
-				fmt.Fprintf(&s, " \x0302%s]\x03", n.Destination)
+				s.WriteString(" " + opts.paint(theme.Link,
+					spoiled(string(n.Destination))+"]"))
 			}
 		case *ast.AutoLink:
 			if enter {
-				fmt.Fprintf(&s, "\x0302%s\x03", n.URL(source))
+				s.WriteString(opts.paint(theme.Link, spoiled(string(n.URL(source)))))
 			}
 		case *md.Inline:
 			switch n.Attr {
@@ -88,44 +260,83 @@ func Content(guildID discord.Snowflake, sess *session.Session, source []byte, m
 			case md.AttrStrikethrough:
 				s.WriteByte(0x1E)
 			case md.AttrSpoiler:
-				if enter {
-					s.WriteString("\x0300,00")
-				} else {
-					s.WriteString("\x03")
+				if !enter {
+					s.WriteString(opts.spoilerEnd())
+					inSpoiler = false
+					break
+				}
+				start, shown := opts.spoilerStart(m)
+				s.WriteString(start)
+				if !shown {
+					return ast.WalkSkipChildren, nil
 				}
+				inSpoiler = true
 			case md.AttrMonospace:
 				if enter {
-					s.WriteString("\x0314")
+					s.WriteString(opts.fg(theme.Monospace))
 				} else {
-					s.WriteString("\x03")
+					s.WriteString(opts.off(theme.Monospace))
 				}
+				inCode = enter
 			case md.AttrQuoted:
 				// not sure what this is
 			}
 		case *md.Emoji:
 			if enter {
-				fmt.Fprintf(&s, "\x0303:%s:\x03", n.Name)
+				s.WriteString(opts.paint(theme.Emoji, ":"+spoiled(n.Name)+":"))
+				if opts.EmojiURLs && n.ID != "" {
+					s.WriteString(" " + opts.paint(theme.Link,
+						spoiled(n.EmojiURL())))
+				}
 			}
 		case *md.Mention:
 			if enter {
 				switch {
 				case n.Channel != nil:
-					fmt.Fprintf(&s, "\x02\x0302#%s\x03\x02", n.Channel.Name)
+					name := "#" + n.Channel.Name
+					if n.Channel.GuildID == guildID && guildID.Valid() {
+						if mapped, err := sess.ChannelName(guildID, n.Channel.ID); err == nil {
+							name = mapped
+						}
+					}
+					fmt.Fprintf(&s, "\x02%s\x02", opts.paint(theme.Mention,
+						spoiled(name)))
 				case n.GuildUser != nil:
 					name, err := sess.UserName(guildID, n.GuildUser.User.ID)
 					if err != nil {
 						name = n.GuildUser.User.Username
 					}
-					fmt.Fprintf(&s, "\x02\x0302@%s\x03\x02", name)
+					fmt.Fprintf(&s, "\x02%s\x02", opts.paint(
+						memberColor(guildID, sess, n.GuildUser, theme.Mention),
+						"@"+spoiled(name)))
+				case n.GuildRole != nil:
+					role := n.GuildRole
+					if guildID.Valid() && role.Name == role.ID.String() {
+						// the message didn't say which guild it is from,
+						// so look in ours, leaving the ID if it's not there
+						if cached, err := sess.Cache().Role(guildID, role.ID); err == nil {
+							role = cached
+						}
+					}
+					c := theme.Mention
+					if role.Color != 0 {
+						c = RGB(role.Color.Uint32())
+					}
+					fmt.Fprintf(&s, "\x02%s\x02", opts.paint(c,
+						"@"+spoiled(role.Name)))
 				}
 			}
 		case *ast.String:
 			if enter {
-				s.Write(md.Unescape(n.Value))
+				s.WriteString(spoiled(string(md.Unescape(n.Value))))
 			}
 		case *ast.Text:
 			if enter {
-				s.Write(md.Unescape(n.Segment.Value(source)))
+				text := string(md.Unescape(n.Segment.Value(source)))
+				if !inCode {
+					text = replaceTimestamps(text, now, opts)
+				}
+				s.WriteString(spoiled(text))
 				switch {
 				case n.HardLineBreak():
 					s.WriteString("\n\n")
@@ -137,96 +348,104 @@ func Content(guildID discord.Snowflake, sess *session.Session, source []byte, m
 		return ast.WalkContinue, nil
 	}
 	ast.Walk(parsed, walker)
-	return s.String()
+	return lineBreaks.Replace(s.String())
 }
 
-func Message(guildID discord.Snowflake, sess *session.Session, m *discord.Message) (string, error) {
+// Message renders a Discord message as IRC lines. Stickers aren't part of
+// gateway messages, so the caller passes in any it has found.
+func Message(guildID discord.Snowflake, sess Session, m *discord.Message,
+	stickers []session.Sticker, opts *Options) (string, error) {
+	theme := opts.Theme
 	if m.Type != discord.DefaultMessage {
 		return "", nil
 	}
 	var s strings.Builder
-	s.WriteString(Content(guildID, sess, []byte(m.Content), m))
+	s.WriteString(Content(guildID, sess, []byte(m.Content), m, opts))
 	for _, e := range m.Embeds {
-		var es strings.Builder
-		if e.Title != "" {
-			fmt.Fprintf(&es, "\x02%s\x02", e.Title)
-			if e.URL != "" {
-				fmt.Fprintf(&es, " \x0302%s\x03", e.URL)
-			}
-			es.WriteString("\n")
-		}
-
-		if e.Description != "" {
-			es.WriteString(Content(guildID, sess, []byte(e.Description), m))
-			es.WriteString("\n")
-		}
-
-		for _, f := range e.Fields {
-			fmt.Fprintf(&es, "\x1D%s:\x1D ", f.Name)
-			if !f.Inline {
-				es.WriteString("\n")
-			}
-			es.WriteString(Content(guildID, sess, []byte(f.Value), m))
-			es.WriteString("\n")
-		}
-		embed := strings.Split(strings.Trim(es.String(), "\n"), "\n")
-		for i, line := range embed {
-			if i == 0 && line == "" {
+		if IsLinkPreview(m, &e) {
+			switch opts.LinkPreviews {
+			case HidePreviews:
+				continue
+			case CollapsePreviews:
+				s.WriteString(collapsedPreview(&e, opts))
 				continue
 			}
-			fmt.Fprintf(&s, "\x03%d▌\x03\x02\x02", color.Nearest(e.Color.Uint32()))
-			s.WriteString(line)
-			s.WriteString("\n")
 		}
+		s.WriteString(embed(guildID, sess, m, &e, opts))
 	}
 	for _, a := range m.Attachments {
 		fmt.Fprintf(&s, "\x02%s\x02 (size: %d", a.Filename, a.Size)
 		if a.Width != 0 && a.Height != 0 {
 			fmt.Fprintf(&s, ", %dx%d", a.Width, a.Height)
 		}
-		fmt.Fprintf(&s, "): \x0302%s\x03", a.URL)
+		if IsSpoilerAttachment(&a) {
+			s.WriteString("): " + opts.spoilerURL(a.URL, m) + "\n")
+			continue
+		}
+		s.WriteString("): " + opts.paint(theme.Link, a.URL))
 		if a.Proxy != strings.Replace(a.URL, "cdn.discordapp.com", "media.discordapp.net", 1) {
-			fmt.Fprintf(&s, " | \x0302%s\x03\n", a.Proxy)
+			s.WriteString(" | " + opts.paint(theme.Link, a.Proxy))
 		}
+		s.WriteString("\n")
 	}
-	return strings.Trim(s.String(), "\n"), nil
+	for _, sticker := range stickers {
+		fmt.Fprintf(&s, "\x02%s\x02 (sticker, %s): %s\n", sticker.Name,
+			sticker.Format, opts.paint(theme.Link, sticker.URL()))
+	}
+	return strings.Trim(lineBreaks.Replace(s.String()), "\n"), nil
 
 }
 
-type ircPrinter struct{}
+type ircPrinter struct {
+	opts *Options
+}
 
-func (ircPrinter) Print(w io.Writer, kind syntaxhighlight.Kind, tokText string) error {
+func (p ircPrinter) Print(w io.Writer, kind syntaxhighlight.Kind, tokText string) error {
 	// we ignore errors since we're always printing into a buffer
+	if kind == syntaxhighlight.Whitespace {
+		io.WriteString(w, tokText)
+		return nil
+	}
+
+	var c Color
 	switch kind {
 	case syntaxhighlight.String:
-		io.WriteString(w, "\x0309")
+		c = p.opts.Theme.String
 	case syntaxhighlight.Keyword:
-		io.WriteString(w, "\x0304")
+		c = p.opts.Theme.Keyword
 	case syntaxhighlight.Comment:
-		io.WriteString(w, "\x0314")
+		c = p.opts.Theme.Comment
 	case syntaxhighlight.Type:
-		io.WriteString(w, "\x0310")
+		c = p.opts.Theme.Type
 	case syntaxhighlight.Literal:
-		io.WriteString(w, "\x0307")
+		c = p.opts.Theme.Literal
 	case syntaxhighlight.Punctuation:
-		io.WriteString(w, "\x0308")
+		c = p.opts.Theme.Punctuation
 	case syntaxhighlight.Plaintext:
-		io.WriteString(w, "\x0300")
+		c = p.opts.Theme.Plaintext
 	case syntaxhighlight.Tag:
-		io.WriteString(w, "\x0300")
+		c = p.opts.Theme.Tag
 	case syntaxhighlight.HTMLTag:
-		io.WriteString(w, "\x0304")
+		c = p.opts.Theme.HTMLTag
 	case syntaxhighlight.HTMLAttrName:
-		io.WriteString(w, "\x0300")
+		c = p.opts.Theme.HTMLAttrName
 	case syntaxhighlight.HTMLAttrValue:
-		io.WriteString(w, "\x0309")
+		c = p.opts.Theme.HTMLAttrValue
 	case syntaxhighlight.Decimal:
-		io.WriteString(w, "\x0307")
+		c = p.opts.Theme.Decimal
 	default:
-		io.WriteString(w, "\x0300")
+		c = p.opts.Theme.Plaintext
+	}
+	// colors don't carry over to the next line, so each line of a
+	// multi-line token is colored separately
+	for i, line := range strings.Split(tokText, "\n") {
+		if i != 0 {
+			io.WriteString(w, "\n")
+		}
+		io.WriteString(w, p.opts.fg(c))
+		io.WriteString(w, "\x02\x02")
+		io.WriteString(w, line)
+		io.WriteString(w, p.opts.off(c))
 	}
-	io.WriteString(w, "\x02\x02")
-	io.WriteString(w, tokText)
-	io.WriteString(w, "\x03")
 	return nil
 }
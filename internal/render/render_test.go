@@ -0,0 +1,211 @@
+package render
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/diamondburned/arikawa/discord"
+	"github.com/diamondburned/arikawa/state"
+	"github.com/tadeokondrak/ircdiscord/internal/session"
+)
+
+var update = flag.Bool("update", false, "rewrite golden files")
+
+// fixture is a recorded message along with the state needed to render it.
+type fixture struct {
+	Guild    *discord.Guild    `json:"guild"`
+	Channels []discord.Channel `json:"channels"`
+	Members  []discord.Member  `json:"members"`
+	Stickers []session.Sticker `json:"stickers"`
+	Options  fixtureOptions    `json:"options"`
+	Message  discord.Message   `json:"message"`
+}
+
+type fixtureOptions struct {
+	Theme     string `json:"theme"`
+	Colors    string `json:"colors"`
+	Previews  string `json:"previews"`
+	Spoilers  string `json:"spoilers"`
+	Timezone  string `json:"timezone"`
+	Locale    string `json:"locale"`
+	EmojiURLs bool   `json:"emoji_urls"`
+}
+
+func (f fixtureOptions) options() (*Options, error) {
+	opts := DefaultOptions()
+	var err error
+	if f.Theme != "" {
+		if opts.Theme, err = LoadTheme(f.Theme); err != nil {
+			return nil, err
+		}
+	}
+	if f.Colors != "" {
+		if opts.Colors, err = ParseColorMode(f.Colors); err != nil {
+			return nil, err
+		}
+	}
+	if f.Previews != "" {
+		if opts.LinkPreviews, err = ParsePreviewMode(f.Previews); err != nil {
+			return nil, err
+		}
+	}
+	if f.Spoilers != "" {
+		if opts.Spoilers, err = ParseSpoilerMode(f.Spoilers); err != nil {
+			return nil, err
+		}
+	}
+	if f.Timezone != "" {
+		if opts.Location, err = time.LoadLocation(f.Timezone); err != nil {
+			return nil, err
+		}
+	}
+	if f.Locale != "" {
+		opts.Locale = f.Locale
+	}
+	opts.EmojiURLs = f.EmojiURLs
+	return opts, nil
+}
+
+// fakeSession answers from a store filled from a fixture, and never talks
+// to Discord.
+type fakeSession struct {
+	store state.Store
+}
+
+func newFakeSession(f *fixture) (*fakeSession, error) {
+	store := state.NewDefaultStore(nil)
+	if f.Guild != nil {
+		if err := store.GuildSet(f.Guild); err != nil {
+			return nil, err
+		}
+		for i := range f.Members {
+			if err := store.MemberSet(f.Guild.ID, &f.Members[i]); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for i := range f.Channels {
+		if err := store.ChannelSet(&f.Channels[i]); err != nil {
+			return nil, err
+		}
+	}
+	return &fakeSession{store: store}, nil
+}
+
+func (s *fakeSession) Cache() state.Store {
+	return s.store
+}
+
+func (s *fakeSession) ChannelName(guild, id discord.Snowflake) (string, error) {
+	channel, err := s.store.Channel(id)
+	if err != nil {
+		return "", err
+	}
+	return "#" + channel.Name, nil
+}
+
+func (s *fakeSession) UserName(guild, id discord.Snowflake) (string, error) {
+	member, err := s.store.Member(guild, id)
+	if err != nil {
+		return "", err
+	}
+	if member.Nick != "" {
+		return member.Nick, nil
+	}
+	return member.User.Username, nil
+}
+
+// visible spells out IRC formatting codes, so golden files can be read and
+// diffed.
+func visible(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if r < 0x20 && r != '\n' {
+			fmt.Fprintf(&b, `\x%02X`, r)
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+func TestGolden(t *testing.T) {
+	paths, err := filepath.Glob(filepath.Join("testdata", "golden", "*.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(paths) == 0 {
+		t.Fatal("no fixtures found")
+	}
+
+	for _, path := range paths {
+		name := strings.TrimSuffix(filepath.Base(path), ".json")
+		t.Run(name, func(t *testing.T) {
+			data, err := ioutil.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var f fixture
+			if err := json.Unmarshal(data, &f); err != nil {
+				t.Fatal(err)
+			}
+
+			opts, err := f.Options.options()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			sess, err := newFakeSession(&f)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var guildID discord.Snowflake
+			if f.Guild != nil {
+				guildID = f.Guild.ID
+			}
+
+			out, err := Message(guildID, sess, &f.Message, f.Stickers, opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := visible(out) + "\n"
+
+			golden := strings.TrimSuffix(path, ".json") + ".golden"
+			if *update {
+				if err := ioutil.WriteFile(golden,
+					[]byte(got), 0644); err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+
+			want, err := ioutil.ReadFile(golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			gotLines := strings.Split(got, "\n")
+			wantLines := strings.Split(string(want), "\n")
+			for i := 0; i < len(gotLines) || i < len(wantLines); i++ {
+				var g, w string
+				if i < len(gotLines) {
+					g = gotLines[i]
+				}
+				if i < len(wantLines) {
+					w = wantLines[i]
+				}
+				if g != w {
+					t.Errorf("line %d:\n got: %s\nwant: %s", i+1, g, w)
+				}
+			}
+		})
+	}
+}
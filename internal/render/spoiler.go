@@ -0,0 +1,119 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/diamondburned/arikawa/discord"
+)
+
+// SpoilerMode selects how spoilers are hidden.
+type SpoilerMode int
+
+const (
+	// ColorSpoilers paints spoilers in the same foreground and background
+	// color, so they can be read by selecting them. Light themes, logs and
+	// notifications show them anyway.
+	ColorSpoilers SpoilerMode = iota
+	// HiddenSpoilers leaves spoilers out and names the message to reveal
+	// them with.
+	HiddenSpoilers
+	// ROT13Spoilers shows spoilers encoded with ROT13.
+	ROT13Spoilers
+	// PlaceholderSpoilers replaces spoilers with a placeholder.
+	PlaceholderSpoilers
+	// ShowSpoilers shows spoilers between ||, like Discord's markup.
+	ShowSpoilers
+)
+
+// ParseSpoilerMode parses the name of a SpoilerMode, one of "color",
+// "hidden", "rot13", "placeholder" or "show".
+func ParseSpoilerMode(name string) (SpoilerMode, error) {
+	switch name {
+	case "color":
+		return ColorSpoilers, nil
+	case "hidden":
+		return HiddenSpoilers, nil
+	case "rot13":
+		return ROT13Spoilers, nil
+	case "placeholder":
+		return PlaceholderSpoilers, nil
+	case "show":
+		return ShowSpoilers, nil
+	default:
+		return 0, fmt.Errorf("unknown spoiler mode %s", name)
+	}
+}
+
+// spoilerStart returns what comes before a spoiler's content, and whether
+// the content itself is shown.
+func (o *Options) spoilerStart(m *discord.Message) (string, bool) {
+	switch o.Spoilers {
+	case HiddenSpoilers:
+		return hiddenSpoiler("spoiler", m), false
+	case PlaceholderSpoilers:
+		return "[spoiler]", false
+	case ROT13Spoilers, ShowSpoilers:
+		return "||", true
+	default:
+		return o.fgbg(o.Theme.Spoiler, o.Theme.Spoiler), true
+	}
+}
+
+// spoilerEnd returns what comes after a spoiler's content.
+func (o *Options) spoilerEnd() string {
+	switch o.Spoilers {
+	case HiddenSpoilers, PlaceholderSpoilers:
+		return ""
+	case ROT13Spoilers, ShowSpoilers:
+		return "||"
+	default:
+		return o.off(o.Theme.Spoiler)
+	}
+}
+
+// hiddenSpoiler returns the text standing in for a hidden spoiler, which
+// names the message so it can be revealed.
+func hiddenSpoiler(what string, m *discord.Message) string {
+	if m == nil || !m.ID.Valid() {
+		return "[" + what + "]"
+	}
+	return fmt.Sprintf("[%s in message %s]", what, m.ID)
+}
+
+// IsSpoilerAttachment returns whether Discord hides an attachment behind a
+// spoiler.
+func IsSpoilerAttachment(a *discord.Attachment) bool {
+	return strings.HasPrefix(a.Filename, "SPOILER_")
+}
+
+// spoilerURL returns the URL of a spoilered attachment as the spoiler mode
+// allows it to be shown.
+func (o *Options) spoilerURL(url string, m *discord.Message) string {
+	switch o.Spoilers {
+	case HiddenSpoilers:
+		return hiddenSpoiler("spoiler attachment", m)
+	case PlaceholderSpoilers:
+		return "[spoiler attachment]"
+	case ROT13Spoilers:
+		return "||" + rot13(url) + "||"
+	case ShowSpoilers:
+		return "||" + o.paint(o.Theme.Link, url) + "||"
+	default:
+		return o.fgbg(o.Theme.Spoiler, o.Theme.Spoiler) + url +
+			o.off(o.Theme.Spoiler)
+	}
+}
+
+func rot13(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z':
+			return 'a' + (r-'a'+13)%26
+		case r >= 'A' && r <= 'Z':
+			return 'A' + (r-'A'+13)%26
+		default:
+			return r
+		}
+	}, s)
+}
@@ -0,0 +1,322 @@
+package render
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/tadeokondrak/ircdiscord/internal/color"
+)
+
+// Color is an IRC color. It is either an index into the mIRC palette, or an
+// exact 24-bit color created with RGB.
+type Color int
+
+// NoColor leaves text in the client's default color.
+const NoColor Color = -1
+
+// isRGB is set on colors created with RGB.
+const isRGB Color = 1 << 24
+
+// RGB returns the Color for a 0xRRGGBB value.
+func RGB(hex uint32) Color {
+	return Color(hex&0xFFFFFF) | isRGB
+}
+
+// IsRGB returns whether c is an exact color rather than a palette index.
+func (c Color) IsRGB() bool {
+	return c != NoColor && c&isRGB != 0
+}
+
+// Hex returns the 0xRRGGBB value of an exact color.
+func (c Color) Hex() uint32 {
+	return uint32(c &^ isRGB)
+}
+
+// Palette returns the mIRC palette index closest to c.
+func (c Color) Palette() int {
+	if c.IsRGB() {
+		return color.Nearest(c.Hex())
+	}
+	return int(c)
+}
+
+// Theme names the color used for each semantic role in rendered output.
+type Theme struct {
+	Link       Color
+	Mention    Color
+	Quote      Color
+	CodeBlock  Color // the gutter in front of code block lines
+	Monospace  Color // inline code
+	Spoiler    Color // used as both foreground and background
+	Edited     Color
+	Emoji      Color
+	EmbedTitle Color
+	EmbedBar   Color // for embeds without a color of their own
+	Timestamp  Color
+
+	// Syntax highlighting in code blocks.
+	String        Color
+	Keyword       Color
+	Comment       Color
+	Type          Color
+	Literal       Color
+	Punctuation   Color
+	Plaintext     Color
+	Tag           Color
+	HTMLTag       Color
+	HTMLAttrName  Color
+	HTMLAttrValue Color
+	Decimal       Color
+
+	// Diffs in code blocks.
+	DiffAdded   Color
+	DiffRemoved Color
+	DiffHunk    Color
+}
+
+// DarkTheme suits clients with a dark background. It is the default.
+var DarkTheme = Theme{
+	Link:       2,
+	Mention:    2,
+	Quote:      9,
+	CodeBlock:  14,
+	Monospace:  14,
+	Spoiler:    0,
+	Edited:     11,
+	Emoji:      3,
+	EmbedTitle: NoColor,
+	EmbedBar:   14,
+	Timestamp:  11,
+
+	String:        9,
+	Keyword:       4,
+	Comment:       14,
+	Type:          10,
+	Literal:       7,
+	Punctuation:   8,
+	Plaintext:     0,
+	Tag:           0,
+	HTMLTag:       4,
+	HTMLAttrName:  0,
+	HTMLAttrValue: 9,
+	Decimal:       7,
+
+	DiffAdded:   9,
+	DiffRemoved: 4,
+	DiffHunk:    10,
+}
+
+// LightTheme suits clients with a light background.
+var LightTheme = Theme{
+	Link:       12,
+	Mention:    12,
+	Quote:      3,
+	CodeBlock:  14,
+	Monospace:  14,
+	Spoiler:    1,
+	Edited:     10,
+	Emoji:      3,
+	EmbedTitle: NoColor,
+	EmbedBar:   14,
+	Timestamp:  10,
+
+	String:        3,
+	Keyword:       5,
+	Comment:       14,
+	Type:          10,
+	Literal:       6,
+	Punctuation:   NoColor,
+	Plaintext:     NoColor,
+	Tag:           NoColor,
+	HTMLTag:       5,
+	HTMLAttrName:  NoColor,
+	HTMLAttrValue: 3,
+	Decimal:       6,
+
+	DiffAdded:   3,
+	DiffRemoved: 5,
+	DiffHunk:    10,
+}
+
+// SolarizedTheme approximates the Solarized accent colors, and works on both
+// its light and dark variants.
+var SolarizedTheme = Theme{
+	Link:       60,
+	Mention:    60,
+	Quote:      43,
+	CodeBlock:  14,
+	Monospace:  10,
+	Spoiler:    14,
+	Edited:     10,
+	Emoji:      43,
+	EmbedTitle: NoColor,
+	EmbedBar:   14,
+	Timestamp:  10,
+
+	String:        10,
+	Keyword:       43,
+	Comment:       14,
+	Type:          8,
+	Literal:       13,
+	Punctuation:   NoColor,
+	Plaintext:     NoColor,
+	Tag:           60,
+	HTMLTag:       60,
+	HTMLAttrName:  8,
+	HTMLAttrValue: 10,
+	Decimal:       13,
+
+	DiffAdded:   43,
+	DiffRemoved: 52,
+	DiffHunk:    60,
+}
+
+// Themes holds the built-in themes by name.
+var Themes = map[string]*Theme{
+	"dark":      &DarkTheme,
+	"light":     &LightTheme,
+	"solarized": &SolarizedTheme,
+}
+
+// themeRoles maps the role names used in theme files to their fields.
+func themeRoles(t *Theme) map[string]*Color {
+	return map[string]*Color{
+		"link":            &t.Link,
+		"mention":         &t.Mention,
+		"quote":           &t.Quote,
+		"code-block":      &t.CodeBlock,
+		"monospace":       &t.Monospace,
+		"spoiler":         &t.Spoiler,
+		"edited":          &t.Edited,
+		"emoji":           &t.Emoji,
+		"embed-title":     &t.EmbedTitle,
+		"embed-bar":       &t.EmbedBar,
+		"timestamp":       &t.Timestamp,
+		"string":          &t.String,
+		"keyword":         &t.Keyword,
+		"comment":         &t.Comment,
+		"type":            &t.Type,
+		"literal":         &t.Literal,
+		"punctuation":     &t.Punctuation,
+		"plaintext":       &t.Plaintext,
+		"tag":             &t.Tag,
+		"html-tag":        &t.HTMLTag,
+		"html-attr-name":  &t.HTMLAttrName,
+		"html-attr-value": &t.HTMLAttrValue,
+		"decimal":         &t.Decimal,
+		"diff-added":      &t.DiffAdded,
+		"diff-removed":    &t.DiffRemoved,
+		"diff-hunk":       &t.DiffHunk,
+	}
+}
+
+// LoadTheme returns the built-in theme called name, or if there is none,
+// reads a theme from the file at that path.
+func LoadTheme(name string) (*Theme, error) {
+	if theme, ok := Themes[name]; ok {
+		copied := *theme
+		return &copied, nil
+	}
+
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, fmt.Errorf("no built-in theme or file named %s", name)
+	}
+	defer f.Close()
+
+	theme, err := ParseTheme(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", name, err)
+	}
+
+	return theme, nil
+}
+
+// ParseTheme reads a theme file.
+//
+// Each line holds a role name followed by a color, which is either a mIRC
+// color number, a #rrggbb hex color or "none". A first line of the form
+// "base <theme>" starts from a built-in theme, and roles not mentioned are
+// taken from it, or from DarkTheme by default. Lines starting with # are
+// ignored.
+func ParseTheme(r io.Reader) (*Theme, error) {
+	theme := DarkTheme
+	roles := themeRoles(&theme)
+
+	first := true
+	scanner := bufio.NewScanner(r)
+	for lineno := 1; scanner.Scan(); lineno++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("line %d: expected a role and a color",
+				lineno)
+		}
+
+		isFirst := first
+		first = false
+
+		if fields[0] == "base" {
+			// a base replaces every role, so it can't come after any
+			if !isFirst {
+				return nil, fmt.Errorf("line %d: base must come first",
+					lineno)
+			}
+			base, ok := Themes[fields[1]]
+			if !ok {
+				return nil, fmt.Errorf("line %d: unknown theme %s",
+					lineno, fields[1])
+			}
+			theme = *base
+			continue
+		}
+
+		role, ok := roles[fields[0]]
+		if !ok {
+			return nil, fmt.Errorf("line %d: unknown role %s",
+				lineno, fields[0])
+		}
+
+		color, err := parseColor(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", lineno, err)
+		}
+
+		*role = color
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return &theme, nil
+}
+
+func parseColor(s string) (Color, error) {
+	if s == "none" {
+		return NoColor, nil
+	}
+
+	if strings.HasPrefix(s, "#") {
+		hex, err := strconv.ParseUint(s[1:], 16, 32)
+		if err != nil || len(s) != 7 {
+			return 0, fmt.Errorf("invalid color %s", s)
+		}
+		return RGB(uint32(hex)), nil
+	}
+
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 0 || n > 98 {
+		return 0, fmt.Errorf("invalid color %s", s)
+	}
+
+	return Color(n), nil
+}
@@ -0,0 +1,28 @@
+package render
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTheme(t *testing.T) {
+	theme, err := ParseTheme(strings.NewReader(
+		"# comment\nbase light\nlink #ff0000\n"))
+	if assert.NoError(t, err) {
+		assert.Equal(t, RGB(0xff0000), theme.Link)
+		assert.Equal(t, LightTheme.Mention, theme.Mention)
+	}
+
+	for _, input := range []string{
+		"link #ff0000\nbase light\n",
+		"base light\nbase dark\n",
+		"base nonexistent\n",
+		"nonexistent 1\n",
+		"link #ff00\n",
+	} {
+		_, err := ParseTheme(strings.NewReader(input))
+		assert.Error(t, err, input)
+	}
+}
@@ -0,0 +1,127 @@
+package render
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// timestampLayouts holds the time.Format layouts for each Discord timestamp
+// style except R.
+type timestampLayouts map[byte]string
+
+// Locales holds the timestamp layouts for each supported locale.
+// Month and weekday names are always in English.
+var Locales = map[string]timestampLayouts{
+	"en-US": {
+		't': "3:04 PM",
+		'T': "3:04:05 PM",
+		'd': "01/02/2006",
+		'D': "January 2, 2006",
+		'f': "January 2, 2006 3:04 PM",
+		'F': "Monday, January 2, 2006 3:04 PM",
+	},
+	"en-GB": {
+		't': "15:04",
+		'T': "15:04:05",
+		'd': "02/01/2006",
+		'D': "2 January 2006",
+		'f': "2 January 2006 15:04",
+		'F': "Monday, 2 January 2006 15:04",
+	},
+	"iso": {
+		't': "15:04",
+		'T': "15:04:05",
+		'd': "2006-01-02",
+		'D': "2006-01-02",
+		'f': "2006-01-02 15:04",
+		'F': "Mon 2006-01-02 15:04 MST",
+	},
+}
+
+// DefaultLocale is used when Options.Locale is empty or unknown.
+const DefaultLocale = "en-US"
+
+var timestampRegex = regexp.MustCompile(`<t:(-?\d{1,13})(?::([tTdDfFR]))?>`)
+
+// replaceTimestamps renders the Discord timestamp markup in text.
+// Relative timestamps are relative to now.
+func replaceTimestamps(text string, now time.Time, opts *Options) string {
+	return timestampRegex.ReplaceAllStringFunc(text, func(match string) string {
+		groups := timestampRegex.FindStringSubmatch(match)
+		unix, err := strconv.ParseInt(groups[1], 10, 64)
+		if err != nil {
+			return match
+		}
+
+		style := byte('f')
+		if groups[2] != "" {
+			style = groups[2][0]
+		}
+
+		t := time.Unix(unix, 0)
+		return opts.paint(opts.Theme.Timestamp, opts.formatTime(t, style, now))
+	})
+}
+
+// formatTime formats t in the given Discord timestamp style, in the
+// location and locale from opts.
+func (o *Options) formatTime(t time.Time, style byte, now time.Time) string {
+	if style == 'R' {
+		return relativeTime(t, now)
+	}
+
+	layouts, ok := Locales[o.Locale]
+	if !ok {
+		layouts = Locales[DefaultLocale]
+	}
+
+	loc := o.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	return t.In(loc).Format(layouts[style])
+}
+
+// relativeTime describes t relative to now, like "in 3 days" or
+// "5 minutes ago".
+func relativeTime(t, now time.Time) string {
+	d := t.Sub(now)
+	future := d > 0
+	if !future {
+		d = -d
+	}
+
+	units := []struct {
+		name string
+		size time.Duration
+	}{
+		{"year", 365 * 24 * time.Hour},
+		{"month", 30 * 24 * time.Hour},
+		{"day", 24 * time.Hour},
+		{"hour", time.Hour},
+		{"minute", time.Minute},
+		{"second", time.Second},
+	}
+
+	amount := "a few seconds"
+	for _, unit := range units {
+		if n := int64(d / unit.size); n > 0 {
+			if unit.size == time.Second && n < 45 {
+				break
+			}
+			amount = fmt.Sprintf("%d %ss", n, unit.name)
+			if n == 1 {
+				amount = fmt.Sprintf("1 %s", unit.name)
+			}
+			break
+		}
+	}
+
+	if future {
+		return "in " + amount
+	}
+	return amount + " ago"
+}
@@ -59,8 +59,15 @@ func JOIN(w Writer, prefix *irc.Prefix, channel string) error {
 	})
 }
 
-func PRIVMSG(w Writer, t time.Time, prefix *irc.Prefix, channel, message string) error {
+// PRIVMSG sends a message. extra holds tags like msgid, which are only sent
+// to clients that enabled message-tags.
+func PRIVMSG(w Writer, t time.Time, extra irc.Tags, prefix *irc.Prefix, channel, message string) error {
 	tags := make(irc.Tags)
+	if w.HasCapability("message-tags") {
+		for key, value := range extra {
+			tags[key] = value
+		}
+	}
 	if w.HasCapability("server-time") && !t.IsZero() {
 		tags["time"] =
 			irc.TagValue(t.UTC().Format("2006-01-02T15:04:05.000Z"))
@@ -81,6 +88,13 @@ func PONG(w Writer, param string) error {
 	})
 }
 
+func ERROR(w Writer, message string) error {
+	return w.WriteMessage(&irc.Message{
+		Command: "ERROR",
+		Params:  []string{message},
+	})
+}
+
 func NOTICE(w Writer, prefix *irc.Prefix, channel, message string) error {
 	return w.WriteMessage(&irc.Message{
 		Prefix:  prefix,
@@ -271,3 +285,72 @@ func RPL_ENDOFNAMES(w Writer, channel string) error {
 		Params:  []string{w.ClientPrefix().Name, channel, "End of /NAMES list"},
 	})
 }
+
+func AUTHENTICATE(w Writer, param string) error {
+	return w.WriteMessage(&irc.Message{
+		Command: "AUTHENTICATE",
+		Params:  []string{param},
+	})
+}
+
+func RPL_LOGGEDIN(w Writer, account string) error {
+	return w.WriteMessage(&irc.Message{
+		Prefix:  w.ServerPrefix(),
+		Command: irc.RPL_LOGGEDIN,
+		Params: []string{w.ClientPrefix().Name, w.ClientPrefix().String(),
+			account, "You are now logged in as " + account},
+	})
+}
+
+func RPL_SASLSUCCESS(w Writer) error {
+	return w.WriteMessage(&irc.Message{
+		Prefix:  w.ServerPrefix(),
+		Command: irc.RPL_SASLSUCCESS,
+		Params: []string{w.ClientPrefix().Name,
+			"SASL authentication successful"},
+	})
+}
+
+func ERR_SASLFAIL(w Writer) error {
+	return w.WriteMessage(&irc.Message{
+		Prefix:  w.ServerPrefix(),
+		Command: irc.ERR_SASLFAIL,
+		Params: []string{w.ClientPrefix().Name,
+			"SASL authentication failed"},
+	})
+}
+
+func ERR_SASLTOOLONG(w Writer) error {
+	return w.WriteMessage(&irc.Message{
+		Prefix:  w.ServerPrefix(),
+		Command: irc.ERR_SASLTOOLONG,
+		Params:  []string{w.ClientPrefix().Name, "SASL message too long"},
+	})
+}
+
+func ERR_SASLABORTED(w Writer) error {
+	return w.WriteMessage(&irc.Message{
+		Prefix:  w.ServerPrefix(),
+		Command: irc.ERR_SASLABORTED,
+		Params: []string{w.ClientPrefix().Name,
+			"SASL authentication aborted"},
+	})
+}
+
+func ERR_SASLALREADY(w Writer) error {
+	return w.WriteMessage(&irc.Message{
+		Prefix:  w.ServerPrefix(),
+		Command: irc.ERR_SASLALREADY,
+		Params: []string{w.ClientPrefix().Name,
+			"You have already authenticated using SASL"},
+	})
+}
+
+func RPL_SASLMECHS(w Writer, mechanisms []string) error {
+	return w.WriteMessage(&irc.Message{
+		Prefix:  w.ServerPrefix(),
+		Command: irc.RPL_SASLMECHS,
+		Params: []string{w.ClientPrefix().Name,
+			strings.Join(mechanisms, ","), "are available SASL mechanisms"},
+	})
+}
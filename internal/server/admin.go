@@ -0,0 +1,112 @@
+package server
+
+import (
+	"sort"
+	"time"
+
+	"github.com/diamondburned/arikawa/discord"
+	"github.com/pkg/errors"
+	"github.com/tadeokondrak/ircdiscord/internal/client"
+)
+
+// ClientInfo describes a connected client to operators.
+type ClientInfo struct {
+	ID uint64
+	client.Info
+}
+
+// SessionInfo describes a Discord session to operators.
+type SessionInfo struct {
+	User discord.Snowflake
+	Refs int // clients using the session
+}
+
+// Clients returns the connected clients, in the order they connected.
+func (s *Server) Clients() []ClientInfo {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	infos := make([]ClientInfo, 0, len(s.clients))
+	for _, cl := range s.clients {
+		infos = append(infos,
+			ClientInfo{ID: s.clientIDs[cl], Info: cl.Info()})
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].ID < infos[j].ID
+	})
+
+	return infos
+}
+
+// Sessions returns the open Discord sessions.
+func (s *Server) Sessions() []SessionInfo {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	infos := make([]SessionInfo, 0, len(s.sessions))
+	for id, sess := range s.sessions {
+		infos = append(infos, SessionInfo{User: id, Refs: sess.Refs()})
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].User < infos[j].User
+	})
+
+	return infos
+}
+
+// Disconnect disconnects the client with the given ID, telling it reason.
+func (s *Server) Disconnect(id uint64, reason string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, cl := range s.clients {
+		if s.clientIDs[cl] == id {
+			cl.Shutdown(reason, time.Now())
+			return nil
+		}
+	}
+
+	return errors.Errorf("no client with ID %d", id)
+}
+
+// CloseSession closes the Discord session of a user by disconnecting the
+// clients using it, telling them reason. The session closes once the last
+// of them is gone, or right away if none are left.
+func (s *Server) CloseSession(user discord.Snowflake, reason string) error {
+	s.mu.Lock()
+	sess, ok := s.sessions[user]
+	var clients []*client.Client
+	for _, cl := range s.clients {
+		if cl.Info().User == user {
+			clients = append(clients, cl)
+		}
+	}
+	s.mu.Unlock()
+
+	if !ok {
+		return errors.Errorf("no session for user %v", user)
+	}
+
+	if len(clients) == 0 {
+		return sess.Close()
+	}
+
+	for _, cl := range clients {
+		cl.Shutdown(reason, time.Now())
+	}
+
+	return nil
+}
+
+// Broadcast sends a NOTICE from the server to every client, returning how
+// many it was sent to.
+func (s *Server) Broadcast(message string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, cl := range s.clients {
+		cl.Notice(message)
+	}
+
+	return len(s.clients)
+}
@@ -0,0 +1,48 @@
+package server
+
+import (
+	"github.com/tadeokondrak/ircdiscord/internal/metrics"
+)
+
+// Collect writes metrics about the server's clients and sessions. Clients and
+// users come and go, so they are summed up rather than given a series each.
+func (s *Server) Collect(w *metrics.Writer) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	w.Gauge("ircdiscord_clients", "Connected IRC clients.",
+		metrics.Sample{Value: float64(len(s.clients))})
+
+	queued, longest := 0, 0
+	for _, cl := range s.clients {
+		n := cl.QueuedEvents()
+		queued += n
+		if n > longest {
+			longest = n
+		}
+	}
+	w.Gauge("ircdiscord_client_write_queue",
+		"Discord events waiting to be written to IRC clients.",
+		metrics.Sample{Value: float64(queued)})
+	w.Gauge("ircdiscord_client_write_queue_max",
+		"Discord events waiting to be written to the furthest behind IRC client.",
+		metrics.Sample{Value: float64(longest)})
+
+	w.Gauge("ircdiscord_sessions", "Open Discord sessions.",
+		metrics.Sample{Value: float64(len(s.sessions))})
+
+	refs, shared := 0, 0
+	for _, sess := range s.sessions {
+		n := sess.Refs()
+		refs += n
+		if n > shared {
+			shared = n
+		}
+	}
+	w.Gauge("ircdiscord_session_refs",
+		"Clients using Discord sessions.",
+		metrics.Sample{Value: float64(refs)})
+	w.Gauge("ircdiscord_session_refs_max",
+		"Clients sharing the most shared Discord session.",
+		metrics.Sample{Value: float64(shared)})
+}
@@ -1,101 +1,225 @@
 package server
 
 import (
-	"log"
 	"net"
 
 	"sync"
+	"time"
 
 	"github.com/diamondburned/arikawa/discord"
 	"github.com/pkg/errors"
 	"github.com/tadeokondrak/ircdiscord/internal/client"
+	"github.com/tadeokondrak/ircdiscord/internal/logging"
+	"github.com/tadeokondrak/ircdiscord/internal/redact"
 	"github.com/tadeokondrak/ircdiscord/internal/session"
 )
 
 // Server is state shared across all connections.
 type Server struct {
-	listener     net.Listener
-	debug        bool
-	ircDebug     bool
-	discordDebug bool
-
-	mu       sync.Mutex                             // guards next 3 fields
-	ids      map[string]discord.Snowflake           // tokens to IDs
-	sessions map[discord.Snowflake]*session.Session // IDs to sessions
-	clients  []*client.Client                       // active clients
+	listeners []net.Listener
+	closeOnce sync.Once // for closing the listeners
+	closeErr  error
+	config    *client.Config
+	logger    *logging.Logger // the root logger, for clients
+	log       *logging.Logger
+
+	mu        sync.Mutex                             // guards next 6 fields
+	ids       map[string]discord.Snowflake           // tokens to IDs
+	sessions  map[discord.Snowflake]*session.Session // IDs to sessions
+	clients   []*client.Client                       // active clients
+	clientIDs map[*client.Client]uint64              // for operators
+	nextID    uint64
+	closed    bool // no new clients
 }
 
-// New creates a new Server, taking ownership of the listener.
-func New(listener net.Listener, debug, ircDebug, discordDebug bool) *Server {
-	if debug {
-		log.Printf("creating server %v", listener.Addr())
+// closeGrace is how long clients are given after the shutdown deadline to
+// send their last messages.
+const closeGrace = time.Second
+
+// New creates a new Server, taking ownership of the listeners.
+// config holds the settings given to each client, and logger is where the
+// server and its clients log to.
+func New(listeners []net.Listener, config *client.Config,
+	logger *logging.Logger) *Server {
+	log := logger.Subsystem("server")
+	for _, ln := range listeners {
+		log.With("addr", ln.Addr()).Debugf("creating server")
 	}
 
 	return &Server{
-		debug:        debug,
-		ircDebug:     ircDebug,
-		discordDebug: discordDebug,
-		listener:     listener,
-		ids:          make(map[string]discord.Snowflake),
-		sessions:     make(map[discord.Snowflake]*session.Session),
+		config:    config,
+		logger:    logger,
+		log:       log,
+		listeners: listeners,
+		ids:       make(map[string]discord.Snowflake),
+		sessions:  make(map[discord.Snowflake]*session.Session),
+		clientIDs: make(map[*client.Client]uint64),
 	}
 }
 
-// Close closes the server's listener, which causes the current Run invocation
-// to unblock and return an error.
+// Close closes the server's listeners, which causes the current Run
+// invocation to unblock and return an error, and disconnects all clients.
 func (s *Server) Close() error {
-	if s.debug {
-		log.Printf("destroying server %v", s.listener.Addr())
+	s.log.Debugf("destroying server")
+
+	s.closeClients()
+
+	return s.closeListeners()
+}
+
+// Shutdown stops accepting connections and disconnects every client with
+// reason, after giving them until timeout to write queued Discord events and
+// finish uploads in progress. Sessions still open once the clients are gone
+// are closed. Events that couldn't be written are reported as an error.
+func (s *Server) Shutdown(reason string, timeout time.Duration) error {
+	s.log.Debugf("shutting down server")
+
+	deadline := time.Now().Add(timeout)
+	err := s.closeListeners()
+
+	s.mu.Lock()
+	s.closed = true
+	clients := append([]*client.Client(nil), s.clients...)
+	s.mu.Unlock()
+
+	for _, cl := range clients {
+		cl.Shutdown(reason, deadline)
+	}
+
+	timer := time.NewTimer(time.Until(deadline) + closeGrace)
+	defer timer.Stop()
+
+wait:
+	for _, cl := range clients {
+		select {
+		case <-cl.Done():
+		case <-timer.C:
+			break wait
+		}
 	}
 
+	// clients that are still running lose what they haven't written
+	dropped := 0
+	for _, cl := range clients {
+		select {
+		case <-cl.Done():
+			dropped += cl.DroppedEvents()
+		default:
+			if queued := cl.QueuedEvents(); queued > 0 {
+				s.log.With("addr", cl.RemoteAddr()).
+					Warnf("%d Discord events were not written", queued)
+				dropped += queued
+			}
+		}
+	}
+	if dropped > 0 && err == nil {
+		err = errors.Errorf("%d Discord events were not written to clients",
+			dropped)
+	}
+
+	s.closeClients()
+
+	s.mu.Lock()
+	var sessions []*session.Session
+	for _, sess := range s.sessions {
+		sessions = append(sessions, sess)
+	}
+	s.mu.Unlock()
+
+	for _, sess := range sessions {
+		if closeErr := sess.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}
+
+	return err
+}
+
+// closeClients disconnects all clients, and keeps new ones from connecting.
+func (s *Server) closeClients() {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	for _, cl := range s.clients {
-		s.removeClientLock(cl)
+	s.closed = true
+	for len(s.clients) > 0 {
+		s.removeClientLock(s.clients[0])
 	}
+}
 
-	return s.listener.Close()
+// closeListeners closes all of the server's listeners, returning the first
+// error. Only the first call closes them.
+func (s *Server) closeListeners() error {
+	s.closeOnce.Do(func() {
+		for _, ln := range s.listeners {
+			if err := ln.Close(); err != nil && s.closeErr == nil {
+				s.closeErr = err
+			}
+		}
+	})
+	return s.closeErr
 }
 
-// Run runs the server, listening for connections until an error is returned.
+// Run runs the server, accepting connections on all listeners until one of
+// them fails. The others are then closed, and Run returns once none of them
+// are accepting connections anymore.
 func (s *Server) Run() error {
-	errs := make(chan error)
+	errs := make(chan error, len(s.listeners))
 	conns := make(chan net.Conn)
-
-	go func() {
-		for {
-			conn, err := s.listener.Accept()
-			if err != nil {
-				errs <- err
-				return
+	done := make(chan struct{})
+
+	var wg sync.WaitGroup
+	for _, ln := range s.listeners {
+		wg.Add(1)
+		go func(ln net.Listener) {
+			defer wg.Done()
+			for {
+				conn, err := ln.Accept()
+				if err != nil {
+					errs <- errors.Wrapf(err,
+						"failed to accept on %v", ln.Addr())
+					return
+				}
+				select {
+				case conns <- conn:
+				case <-done:
+					conn.Close()
+					return
+				}
 			}
-			conns <- conn
-		}
-	}()
+		}(ln)
+	}
 
 	for {
 		select {
 		case conn := <-conns:
 			go s.runClient(conn)
 		case err := <-errs:
+			close(done)
+			s.closeListeners()
+			wg.Wait()
 			return err
 		}
 	}
 }
 
-// runClient runs a client.Client on the given connection, with the debug
-// settings given in its arguments.
+// runClient runs a client.Client on the given connection.
 func (s *Server) runClient(conn net.Conn) {
-	cl := client.New(conn, s.session, s.debug, s.ircDebug, s.discordDebug)
+	cl := client.New(conn, s.session, s.config, s.logger)
 	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		cl.Close()
+		return
+	}
 	s.clients = append(s.clients, cl)
+	s.nextID++
+	s.clientIDs[cl] = s.nextID
 	s.mu.Unlock()
 	defer s.removeClient(cl)
 
 	if err := cl.Run(); err != nil {
-		log.Printf("client %v disconnected with error: %v",
-			conn.RemoteAddr(), err)
+		s.log.With("addr", conn.RemoteAddr(), "error", err).
+			Infof("client disconnected with error")
 	}
 }
 
@@ -116,6 +240,7 @@ func (s *Server) removeClientLock(cl *client.Client) error {
 			s.clients[i] = s.clients[len(s.clients)-1]
 			s.clients[len(s.clients)-1] = nil
 			s.clients = s.clients[:len(s.clients)-1]
+			delete(s.clientIDs, cl)
 
 			s.mu.Unlock()
 			err := cl.Close()
@@ -129,35 +254,27 @@ func (s *Server) removeClientLock(cl *client.Client) error {
 
 // session returns the session.Session for the given token.
 // It may be called concurrently from multiple goroutines.
-func (s *Server) session(token string, debug bool) (*session.Session, error) {
+func (s *Server) session(token string) (*session.Session, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	if s.debug {
-		log.Printf("requested session for token %s", token)
-	}
+	log := s.log.With("token", redact.Token(token))
+	log.Debugf("requested session")
 
 	if id, ok := s.ids[token]; ok {
-		if s.debug {
-			log.Printf("found id %v for token %s", id, token)
-		}
+		log.Debugf("found user %v", id)
 
 		if sess, ok := s.sessions[id]; ok {
-			if s.debug {
-				log.Printf("found session %p for token %s",
-					sess, token)
-			}
+			log.Debugf("found session %p", sess)
 
 			sess.Ref()
 			return sess, nil
 		}
 	}
 
-	if s.debug {
-		log.Printf("no session for token %s found, creating one", token)
-	}
+	log.Debugf("no session found, creating one")
 
-	sess, err := session.New(token, debug, s.removeSession)
+	sess, err := session.New(token, s.removeSession)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create session")
 	}
@@ -171,18 +288,19 @@ func (s *Server) session(token string, debug bool) (*session.Session, error) {
 
 	for otherID, other := range s.sessions {
 		if me.ID == otherID {
-			if s.debug {
-				log.Printf("different token was used to log " +
-					"into existing session, " +
-					"closing new session")
-			}
+			log.With("user", me.ID).Debugf("different token was " +
+				"used to log into existing session, " +
+				"closing new session")
 
-			sess.Close()
+			// not in s.sessions, so only its connection is closed
+			sess.State.Close()
+			other.Ref()
 			return other, nil
 		}
 	}
 
 	s.sessions[me.ID] = sess
+	sess.Ref()
 
 	return sess, nil
 }
@@ -194,10 +312,7 @@ func (s *Server) removeSession(sess *session.Session) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	if s.debug {
-		log.Printf("removing session %p from server %v",
-			sess, s.listener.Addr())
-	}
+	s.log.Debugf("removing session %p from server", sess)
 
 	for id, other := range s.sessions {
 		if sess == other {
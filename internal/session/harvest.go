@@ -94,6 +94,26 @@ func (s *Session) harvestChannels(channels []discord.Channel) {
 }
 
 func (s *Session) harvestGuild(guild *discord.Guild) {
+	// unavailable guilds come without emojis
+	if guild.Emojis != nil {
+		s.harvestEmojis(guild.ID, guild.Emojis)
+	}
+}
+
+// harvestEmojis replaces the known custom emojis of a guild.
+func (s *Session) harvestEmojis(guild discord.Snowflake, emojis []discord.Emoji) {
+	if !guild.Valid() {
+		return
+	}
+
+	names := make(map[string]discord.Emoji, len(emojis))
+	for _, emoji := range emojis {
+		names[emoji.Name] = emoji
+	}
+
+	s.emojiMapsMutex.Lock()
+	s.emojiMaps[guild] = names
+	s.emojiMapsMutex.Unlock()
 }
 
 func (s *Session) harvestGuilds(guilds []discord.Guild) {
@@ -179,6 +199,7 @@ func (s *Session) onEventHarvest(e interface{}) {
 	case *gateway.GuildBanAddEvent:
 	case *gateway.GuildBanRemoveEvent:
 	case *gateway.GuildEmojisUpdateEvent:
+		s.harvestEmojis(e.GuildID, e.Emojis)
 	case *gateway.GuildIntegrationsUpdateEvent:
 	case *gateway.GuildMemberAddEvent:
 		s.harvestMember(e.GuildID, &e.Member)
@@ -0,0 +1,46 @@
+package session
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/diamondburned/arikawa/gateway"
+	"github.com/diamondburned/arikawa/utils/httputil"
+	"github.com/diamondburned/arikawa/utils/httputil/httpdriver"
+	"github.com/tadeokondrak/ircdiscord/internal/metrics"
+)
+
+// instrument records the latency of the client's requests, and how many are
+// rate limited. Latency is measured once the rate limiter lets a request
+// through, so waiting on it isn't counted.
+func instrument(client *httputil.Client) {
+	client.Client = timedDriver{client.Client}
+}
+
+// timedDriver times each request as it is sent, after the client's request
+// options, including the rate limiter, have run.
+type timedDriver struct {
+	httpdriver.Client
+}
+
+func (d timedDriver) Do(req httpdriver.Request) (httpdriver.Response, error) {
+	start := time.Now()
+	resp, err := d.Client.Do(req)
+	metrics.DiscordRequestDuration.Observe(time.Since(start).Seconds())
+	if err == nil && resp.GetStatus() == httputil.StatusTooManyRequests {
+		metrics.DiscordRateLimited.Inc()
+	}
+	return resp, err
+}
+
+// countReconnect counts gateway connections after the first.
+func (s *Session) countReconnect(e interface{}) {
+	switch e.(type) {
+	case *gateway.ReadyEvent:
+		if atomic.AddUint32(&s.readies, 1) > 1 {
+			metrics.GatewayReconnects.Inc()
+		}
+	case *gateway.ResumedEvent:
+		metrics.GatewayReconnects.Inc()
+	}
+}
@@ -28,8 +28,14 @@ type Session struct {
 	nickMapsMutex    sync.RWMutex
 	channelMaps      map[discord.Snowflake]*idmap.IDMap
 	channelMapsMutex sync.RWMutex
+	emojiMaps        map[discord.Snowflake]map[string]discord.Emoji
+	emojiMapsMutex   sync.RWMutex
+	stickers         map[discord.Snowflake][]Sticker // by message
+	stickerOrder     []discord.Snowflake             // oldest first
+	stickersMutex    sync.Mutex
 	id               discord.Snowflake
 	refs             uint32
+	readies          uint32 // gateway Ready events received
 }
 
 // RemoveFunc is a function type used to remove a Session from some storage
@@ -38,7 +44,7 @@ type RemoveFunc func(s *Session)
 
 // New creates a new Session.
 // removeFunc is a function that will called on Close.
-func New(token string, debug bool, removeFunc RemoveFunc) (*Session, error) {
+func New(token string, removeFunc RemoveFunc) (*Session, error) {
 	plain, err := state.New(token)
 	if err != nil {
 		return nil, err
@@ -56,10 +62,14 @@ func New(token string, debug bool, removeFunc RemoveFunc) (*Session, error) {
 		userMap:         make(map[discord.Snowflake]string),
 		nickMaps:        make(map[discord.Snowflake]*idmap.IDMap),
 		channelMaps:     make(map[discord.Snowflake]*idmap.IDMap),
+		emojiMaps:       make(map[discord.Snowflake]map[string]discord.Emoji),
+		stickers:        make(map[discord.Snowflake][]Sticker),
 		refs:            0,
 	}
 
 	state.AddHandler(s.onEventHarvest)
+	state.AddHandler(s.countReconnect)
+	instrument(plain.Client.Client)
 
 	if err := state.Open(); err != nil {
 		return nil, err
@@ -80,6 +90,16 @@ func (s *Session) Ref() {
 	atomic.AddUint32(&s.refs, 1)
 }
 
+// Refs returns the reference count.
+func (s *Session) Refs() int {
+	return int(atomic.LoadUint32(&s.refs))
+}
+
+// ID returns the ID of the Discord user the session is for.
+func (s *Session) ID() discord.Snowflake {
+	return s.id
+}
+
 // Unref decrements the reference count, calling Close if it hits zero.
 func (s *Session) Unref() error {
 	if atomic.AddUint32(&s.refs, ^uint32(0)) == 0 {
@@ -112,6 +132,41 @@ func (s *Session) Messages(
 	return messages, err
 }
 
+// maxMessagesPage is the most messages Discord returns for one request.
+const maxMessagesPage = 100
+
+// LatestMessages returns up to limit of the latest messages in a channel,
+// newest first, fetching older pages when the state doesn't hold enough.
+func (s *Session) LatestMessages(channelID discord.Snowflake,
+	limit int) ([]discord.Message, error) {
+	messages, err := s.Messages(channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	for len(messages) > 0 && len(messages) < limit {
+		page := limit - len(messages)
+		if page > maxMessagesPage {
+			page = maxMessagesPage
+		}
+		oldest := messages[len(messages)-1].ID
+		older, err := s.MessagesBefore(channelID, oldest, uint(page))
+		if err != nil {
+			return nil, err
+		}
+		s.harvestMessages(older)
+		messages = append(messages, older...)
+		if len(older) < page {
+			break
+		}
+	}
+
+	if len(messages) > limit {
+		messages = messages[:limit]
+	}
+	return messages, nil
+}
+
 func safeGetMap(maps map[discord.Snowflake]*idmap.IDMap,
 	id discord.Snowflake, mu *sync.RWMutex) *idmap.IDMap {
 	mu.RLock()
@@ -265,6 +320,35 @@ func (s *Session) ChannelName(guild discord.Snowflake,
 	return fmt.Sprintf("#%s", post), nil
 }
 
+// Cache returns the state store, which unlike the methods of the State
+// never makes requests to Discord.
+func (s *Session) Cache() state.Store {
+	return s.Store
+}
+
+// EmojiFromName returns the custom emoji called name in the given guild.
+func (s *Session) EmojiFromName(guild discord.Snowflake,
+	name string) (discord.Emoji, bool) {
+	s.emojiMapsMutex.RLock()
+	emojis, ok := s.emojiMaps[guild]
+	s.emojiMapsMutex.RUnlock()
+
+	if !ok {
+		list, err := s.Emojis(guild)
+		if err != nil {
+			return discord.Emoji{}, false
+		}
+		s.harvestEmojis(guild, list)
+
+		s.emojiMapsMutex.RLock()
+		emojis = s.emojiMaps[guild]
+		s.emojiMapsMutex.RUnlock()
+	}
+
+	emoji, ok := emojis[name]
+	return emoji, ok
+}
+
 // sanitizeNick removes characters invalid in an IRC nickname from a string.
 func sanitizeNick(s string) string {
 	return strings.Map(func(r rune) rune {
@@ -0,0 +1,131 @@
+package session
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/diamondburned/arikawa/api"
+	"github.com/diamondburned/arikawa/discord"
+	"github.com/diamondburned/arikawa/utils/httputil"
+)
+
+// The version of arikawa we use predates stickers, so discord.Message drops
+// them and they are fetched here with raw requests instead.
+
+// StickerFormat is the file format of a sticker.
+type StickerFormat int
+
+const (
+	PNGSticker    StickerFormat = 1
+	APNGSticker   StickerFormat = 2
+	LottieSticker StickerFormat = 3
+	GIFSticker    StickerFormat = 4
+)
+
+func (f StickerFormat) String() string {
+	switch f {
+	case PNGSticker:
+		return "png"
+	case APNGSticker:
+		return "apng"
+	case LottieSticker:
+		return "lottie"
+	case GIFSticker:
+		return "gif"
+	default:
+		return fmt.Sprintf("format %d", int(f))
+	}
+}
+
+// Sticker is a sticker attached to a message or belonging to a guild.
+type Sticker struct {
+	ID     discord.Snowflake `json:"id"`
+	Name   string            `json:"name"`
+	Format StickerFormat     `json:"format_type"`
+}
+
+// URL returns the sticker's CDN URL. Lottie stickers are JSON animations
+// that few things besides Discord can play.
+func (s Sticker) URL() string {
+	ext := "png"
+	switch s.Format {
+	case LottieSticker:
+		ext = "json"
+	case GIFSticker:
+		ext = "gif"
+	}
+	return "https://media.discordapp.net/stickers/" + s.ID.String() + "." + ext
+}
+
+// stickerCacheSize is the number of messages whose stickers are remembered.
+const stickerCacheSize = 1000
+
+// MessageStickers returns the stickers sent with a message. Messages from
+// the gateway leave them out, so they are fetched, once per message.
+func (s *Session) MessageStickers(channelID,
+	messageID discord.Snowflake) ([]Sticker, error) {
+	s.stickersMutex.Lock()
+	stickers, ok := s.stickers[messageID]
+	s.stickersMutex.Unlock()
+	if ok {
+		return stickers, nil
+	}
+
+	var msg struct {
+		StickerItems []Sticker `json:"sticker_items"`
+	}
+	if err := s.RequestJSON(&msg, "GET", api.EndpointChannels+
+		channelID.String()+"/messages/"+messageID.String()); err != nil {
+		return nil, err
+	}
+
+	s.stickersMutex.Lock()
+	defer s.stickersMutex.Unlock()
+	if _, ok := s.stickers[messageID]; !ok {
+		if len(s.stickerOrder) == stickerCacheSize {
+			delete(s.stickers, s.stickerOrder[0])
+			s.stickerOrder = s.stickerOrder[1:]
+		}
+		s.stickerOrder = append(s.stickerOrder, messageID)
+	}
+	s.stickers[messageID] = msg.StickerItems
+
+	return msg.StickerItems, nil
+}
+
+// GuildStickers returns the stickers uploaded to a guild.
+func (s *Session) GuildStickers(guildID discord.Snowflake) ([]Sticker, error) {
+	var stickers []Sticker
+	err := s.RequestJSON(&stickers, "GET",
+		api.EndpointGuilds+guildID.String()+"/stickers")
+	return stickers, err
+}
+
+// StickerFromName returns the guild's sticker called name, ignoring case.
+func (s *Session) StickerFromName(guildID discord.Snowflake,
+	name string) (*Sticker, error) {
+	stickers, err := s.GuildStickers(guildID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, sticker := range stickers {
+		if strings.EqualFold(sticker.Name, name) {
+			return &sticker, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no sticker named %s", name)
+}
+
+// SendSticker sends a message consisting of only a sticker.
+func (s *Session) SendSticker(channelID,
+	stickerID discord.Snowflake) (*discord.Message, error) {
+	var msg *discord.Message
+	err := s.RequestJSON(&msg, "POST",
+		api.EndpointChannels+channelID.String()+"/messages",
+		httputil.WithJSONBody(struct {
+			StickerIDs []discord.Snowflake `json:"sticker_ids"`
+		}{[]discord.Snowflake{stickerID}}))
+	return msg, err
+}
@@ -0,0 +1,134 @@
+// Package vault encrypts secrets kept at rest, such as Discord tokens, with a
+// key derived from a server secret.
+//
+// Keys are derived with Argon2id, and secrets are sealed with
+// XChaCha20-Poly1305 under a random nonce.
+package vault
+
+import (
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+// Params are the parameters of the key derivation, stored alongside the
+// sealed secrets.
+type Params struct {
+	Salt    []byte `json:"salt"`
+	Time    uint32 `json:"time"`
+	Memory  uint32 `json:"memory"` // in KiB
+	Threads uint8  `json:"threads"`
+}
+
+// NewParams returns parameters with a new random salt, following the
+// recommendations of RFC 9106.
+func NewParams() (*Params, error) {
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	return &Params{Salt: salt, Time: 1, Memory: 64 * 1024, Threads: 4}, nil
+}
+
+// Vault seals and opens secrets.
+type Vault struct {
+	aead cipher.AEAD
+}
+
+// New derives a key from secret, which is slow on purpose.
+func New(secret []byte, params *Params) (*Vault, error) {
+	if len(secret) == 0 {
+		return nil, fmt.Errorf("empty secret")
+	}
+	if len(params.Salt) == 0 || params.Time == 0 || params.Threads == 0 {
+		return nil, fmt.Errorf("invalid key derivation parameters")
+	}
+
+	key := argon2.IDKey(secret, params.Salt, params.Time, params.Memory,
+		params.Threads, chacha20poly1305.KeySize)
+
+	aead, err := chacha20poly1305.NewX(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Vault{aead: aead}, nil
+}
+
+// Seal encrypts plaintext, binding it to context, which must be given again
+// to open it.
+func (v *Vault) Seal(plaintext []byte, context string) (string, error) {
+	nonce := make([]byte, v.aead.NonceSize(),
+		v.aead.NonceSize()+len(plaintext)+v.aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	sealed := v.aead.Seal(nonce, nonce, plaintext, []byte(context))
+
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Open decrypts a secret sealed with the same key and context.
+func (v *Vault) Open(sealed string, context string) ([]byte, error) {
+	data, err := base64.StdEncoding.DecodeString(sealed)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < v.aead.NonceSize() {
+		return nil, fmt.Errorf("sealed secret too short")
+	}
+
+	nonce, ciphertext := data[:v.aead.NonceSize()], data[v.aead.NonceSize():]
+	plaintext, err := v.aead.Open(nil, nonce, ciphertext, []byte(context))
+	if err != nil {
+		return nil, fmt.Errorf("wrong secret or corrupted data")
+	}
+
+	return plaintext, nil
+}
+
+// PassphraseEnv is the environment variable a passphrase can be given in,
+// instead of a secret file.
+const PassphraseEnv = "IRCDISCORD_PASSPHRASE"
+
+// ReadSecret reads the server secret from a file, which others may not be
+// able to read, or from PassphraseEnv if path is empty.
+func ReadSecret(path string) ([]byte, error) {
+	if path == "" {
+		passphrase := os.Getenv(PassphraseEnv)
+		if passphrase == "" {
+			return nil, fmt.Errorf(
+				"a secret file or %s is needed", PassphraseEnv)
+		}
+		return []byte(passphrase), nil
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if info.Mode().Perm()&0077 != 0 {
+		return nil, fmt.Errorf("%s can be read by others, "+
+			"only its owner should have access", path)
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	secret := []byte(strings.TrimRight(string(data), "\r\n"))
+	if len(secret) == 0 {
+		return nil, fmt.Errorf("%s is empty", path)
+	}
+
+	return secret, nil
+}
@@ -0,0 +1,73 @@
+package vault
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSeal(t *testing.T) {
+	params, err := NewParams()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	v, err := New([]byte("secret"), params)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	sealed, err := v.Seal([]byte("token"), "token:alice")
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.NotContains(t, sealed, "token")
+
+	other, err := v.Seal([]byte("token"), "token:alice")
+	if assert.NoError(t, err) {
+		assert.NotEqual(t, sealed, other, "nonces must differ")
+	}
+
+	opened, err := v.Open(sealed, "token:alice")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "token", string(opened))
+	}
+
+	_, err = v.Open(sealed, "token:bob")
+	assert.Error(t, err)
+
+	wrong, err := New([]byte("wrong"), params)
+	if assert.NoError(t, err) {
+		_, err = wrong.Open(sealed, "token:alice")
+		assert.Error(t, err)
+	}
+
+	_, err = v.Open("", "token:alice")
+	assert.Error(t, err)
+}
+
+func TestReadSecret(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ircdiscord")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "secret")
+
+	if err := ioutil.WriteFile(path, []byte("secret\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	_, err = ReadSecret(path)
+	assert.Error(t, err, "readable by others")
+
+	if err := os.Chmod(path, 0600); err != nil {
+		t.Fatal(err)
+	}
+	secret, err := ReadSecret(path)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "secret", string(secret))
+	}
+}
@@ -4,106 +4,404 @@ import (
 	"crypto/tls"
 	"flag"
 	"fmt"
+	"io/ioutil"
 	"log"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
 
+	"github.com/diamondburned/arikawa/discord"
+	"github.com/diamondburned/arikawa/utils/wsutil"
 	"github.com/pkg/errors"
+	"github.com/tadeokondrak/ircdiscord/internal/admin"
+	"github.com/tadeokondrak/ircdiscord/internal/client"
+	"github.com/tadeokondrak/ircdiscord/internal/config"
+	"github.com/tadeokondrak/ircdiscord/internal/logging"
+	"github.com/tadeokondrak/ircdiscord/internal/metrics"
+	"github.com/tadeokondrak/ircdiscord/internal/redact"
+	"github.com/tadeokondrak/ircdiscord/internal/render"
 	"github.com/tadeokondrak/ircdiscord/internal/server"
 )
 
-func listen(port int) (net.Listener, error) {
-	if port == 0 {
-		port = 6667
+// listen creates a listener, wrapping it with tlsConfig if it is a TLS one.
+func listen(l *config.Listener, tlsConfig *tls.Config) (net.Listener, error) {
+	var listener net.Listener
+	var err error
+	if l.Network == "unix" {
+		listener, err = listenUnix(l)
+	} else {
+		listener, err = net.Listen(l.Network, l.Address)
 	}
-	addr := fmt.Sprintf(":%d", port)
-
-	listener, err := net.Listen("tcp", addr)
 	if err != nil {
-		return nil, errors.Wrap(err,
-			"failed to create listener")
+		return nil, errors.Wrapf(err,
+			"failed to create listener %v", l)
+	}
+
+	if l.TLS {
+		listener = tls.NewListener(listener, tlsConfig)
 	}
 
 	return listener, nil
 }
 
-func listenTLS(port int, certfile, keyfile string) (net.Listener, error) {
-	if certfile == "" || keyfile == "" {
-		return nil, errors.New(
-			"certfile and keyfile are required for TLS")
+// listenUnix listens on a Unix socket, refusing to take over one that is in
+// use. A socket with a mode is made in a private directory and moved into
+// place once it has the mode, so it is never reachable with the default one.
+func listenUnix(l *config.Listener) (net.Listener, error) {
+	if info, err := os.Lstat(l.Address); err == nil &&
+		info.Mode()&os.ModeSocket != 0 {
+		conn, err := net.DialTimeout("unix", l.Address, time.Second)
+		if err == nil {
+			conn.Close()
+			return nil, errors.Errorf("%s is in use", l.Address)
+		}
+		// left behind by a previous run, which makes listening fail
+		os.Remove(l.Address)
 	}
 
-	cert, err := tls.LoadX509KeyPair(certfile, keyfile)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to load keypair")
+	if l.Mode == 0 {
+		return net.Listen("unix", l.Address)
 	}
 
-	config := &tls.Config{Certificates: []tls.Certificate{cert}}
-
-	if port == 0 {
-		port = 6697
+	dir, err := ioutil.TempDir(filepath.Dir(l.Address), ".ircdiscord")
+	if err != nil {
+		return nil, err
 	}
-	addr := fmt.Sprintf(":%d", port)
+	defer os.RemoveAll(dir)
 
-	listener, err := tls.Listen("tcp", addr, config)
+	tmp := filepath.Join(dir, "socket")
+	listener, err := net.ListenUnix("unix",
+		&net.UnixAddr{Name: tmp, Net: "unix"})
 	if err != nil {
-		return nil, errors.Wrap(err,
-			"failed to create listener")
+		return nil, err
 	}
+	// the socket is removed from where it ends up instead
+	listener.SetUnlinkOnClose(false)
 
-	return listener, nil
+	if err := os.Chmod(tmp, l.Mode); err != nil {
+		listener.Close()
+		return nil, err
+	}
+	if err := os.Rename(tmp, l.Address); err != nil {
+		listener.Close()
+		return nil, err
+	}
+
+	return &unixListener{UnixListener: listener, path: l.Address}, nil
+}
+
+// unixListener removes its socket when closed.
+type unixListener struct {
+	*net.UnixListener
+	path string
+}
+
+func (l *unixListener) Close() error {
+	err := l.UnixListener.Close()
+	os.Remove(l.path)
+	return err
 }
 
 func main() {
 	var (
+		configFile   string
 		debug        bool
 		ircDebug     bool
 		discordDebug bool
+		logFormat    string
+		logLevel     string
 		port         int
 		tlsEnabled   bool
 		certfile     string
 		keyfile      string
+		backlog      int
+		theme        string
+		colors       string
+		previews     string
+		edits        string
+		spoilers     string
+		timezone     string
+		locale       string
+		emojiURLs    bool
+		uploadDir    string
+		uploadLimit  int64
+		uploadHTTP   bool
+		accountsFile string
+		secretFile   string
+		metricsAddr  string
 	)
 
+	if len(os.Args) > 1 && os.Args[1] == "accounts" {
+		if err := runAccounts(os.Args[2:]); err == flag.ErrHelp {
+			os.Exit(2)
+		} else if err != nil {
+			log.SetFlags(0)
+			log.Fatalln(err)
+		}
+		return
+	}
+
+	flag.StringVar(&configFile, "config", "",
+		"configuration file, which the other flags take precedence over")
 	flag.BoolVar(&debug, "debug", false,
 		"enable verbose logging")
 	flag.BoolVar(&ircDebug, "ircdebug", false,
 		"enable verbose logging of irc communication")
 	flag.BoolVar(&discordDebug, "discorddebug", false,
 		"enable verbose logging of discord communication")
+	flag.StringVar(&logFormat, "logformat", "text",
+		"log format: text or json")
+	flag.StringVar(&logLevel, "loglevel", "info",
+		"level of logs: debug, info, warn, error or off")
 	flag.IntVar(&port, "port", 0,
 		"port to run on, defaults to 6667/6697 depending on tls")
 	flag.BoolVar(&tlsEnabled, "tls", false, "enable tls encryption")
 	flag.StringVar(&certfile, "cert", "", "tls certificate file")
 	flag.StringVar(&keyfile, "key", "", "tls key file")
+	flag.IntVar(&backlog, "backlog", config.DefaultBacklog,
+		"number of messages shown when joining a channel")
+	flag.StringVar(&theme, "theme", "dark",
+		"color theme: dark, light, solarized or a theme file")
+	flag.StringVar(&colors, "colors", "palette",
+		"color output: palette, or hex for clients supporting \\x04 colors")
+	flag.StringVar(&previews, "previews", "show",
+		"link preview embeds: show, collapse or hide")
+	flag.StringVar(&edits, "edits", "diff",
+		"edited messages: diff, or tag to resend them with +draft/edit")
+	flag.StringVar(&spoilers, "spoilers", "color",
+		"spoilers: color, hidden, rot13, placeholder or show")
+	flag.StringVar(&timezone, "timezone", "UTC",
+		"time zone for timestamps, e.g. Europe/Berlin or Local")
+	flag.StringVar(&locale, "locale", render.DefaultLocale,
+		"format for timestamps: en-US, en-GB or iso")
+	flag.BoolVar(&emojiURLs, "emojiurls", false,
+		"show image links after custom emoji")
+	flag.StringVar(&uploadDir, "uploaddir", "",
+		"directory files can be uploaded from, disabled if empty")
+	flag.Int64Var(&uploadLimit, "uploadlimit", config.DefaultUploadLimit,
+		"largest file that can be uploaded, in bytes")
+	flag.BoolVar(&uploadHTTP, "uploadhttp", false,
+		"allow uploading files from public http(s) URLs")
+	flag.StringVar(&accountsFile, "accounts", "",
+		"file of accounts to log in to with SASL, disabled if empty")
+	flag.StringVar(&secretFile, "secret", "",
+		"file of the secret that encrypts the tokens of accounts")
+	flag.StringVar(&metricsAddr, "metrics", "",
+		"address to serve Prometheus metrics on, disabled if empty")
 	flag.Parse()
 
-	if !debug {
-		log.SetFlags(0)
-	} else {
-		log.SetFlags(log.Lshortfile)
+	cfg := config.Default()
+	if configFile != "" {
+		var err error
+		cfg, err = config.Load(configFile)
+		if err != nil {
+			log.Fatalln(err)
+		}
+	}
+
+	portSet := false
+	var flagErr error
+	flag.Visit(func(f *flag.Flag) {
+		var err error
+		switch f.Name {
+		case "debug":
+			if debug {
+				cfg.Log.Level = logging.Debug
+			}
+		case "ircdebug":
+			if ircDebug {
+				cfg.Log.Levels["irc"] = logging.Debug
+			}
+		case "discorddebug":
+			if discordDebug {
+				cfg.Log.Levels["discord"] = logging.Debug
+			}
+		case "logformat":
+			cfg.Log.Format, err = logging.ParseFormat(logFormat)
+		case "loglevel":
+			cfg.Log.Level, err = logging.ParseLevel(logLevel)
+		case "port":
+			portSet = true
+		case "tls":
+			if !tlsEnabled {
+				cfg.TLS = nil
+			} else if cfg.TLS == nil {
+				cfg.TLS = &config.TLS{}
+			}
+		case "backlog":
+			cfg.Backlog = backlog
+		case "theme":
+			cfg.Render.Theme = theme
+		case "colors":
+			cfg.Render.Colors = colors
+		case "previews":
+			cfg.Render.Previews = previews
+		case "edits":
+			cfg.Render.Edits = edits
+		case "spoilers":
+			cfg.Render.Spoilers = spoilers
+		case "timezone":
+			cfg.Render.Timezone = timezone
+		case "locale":
+			cfg.Render.Locale = locale
+		case "emojiurls":
+			cfg.Render.EmojiURLs = &emojiURLs
+		case "uploaddir":
+			cfg.UploadDir = uploadDir
+		case "uploadlimit":
+			cfg.UploadLimit = uploadLimit
+		case "uploadhttp":
+			cfg.UploadHTTP = uploadHTTP
+		case "accounts":
+			cfg.Accounts = accountsFile
+		case "secret":
+			cfg.SecretFile = secretFile
+		case "metrics":
+			cfg.Metrics = metricsAddr
+		}
+		if err != nil && flagErr == nil {
+			flagErr = errors.Wrapf(err, "-%s", f.Name)
+		}
+	})
+	if flagErr != nil {
+		log.Fatalln(flagErr)
+	}
+	if cfg.TLS != nil {
+		if certfile != "" {
+			cfg.TLS.Cert = certfile
+		}
+		if keyfile != "" {
+			cfg.TLS.Key = keyfile
+		}
+	}
+	if portSet {
+		l := config.DefaultListener(cfg.TLS != nil)
+		l.Address = fmt.Sprintf(":%d", port)
+		cfg.Listeners = []*config.Listener{l}
+	} else if len(cfg.Listeners) == 0 {
+		cfg.Listeners = []*config.Listener{
+			config.DefaultListener(cfg.TLS != nil),
+		}
+	}
+
+	if err := cfg.Validate(); err != nil {
+		log.Fatalln(err)
+	}
+
+	// tokens that end up in messages, errors or debug output stay out of
+	// the logs
+	root := logging.New(redact.NewWriter(os.Stderr), cfg.Log.Options())
+	logger := root.Subsystem("server")
+
+	// the libraries used for Discord log with the log package
+	discordLog := root.Subsystem("discord")
+	log.SetFlags(0)
+	log.SetOutput(discordLog.Writer(logging.Warn))
+	wsutil.WSError = func(err error) {
+		discordLog.With("error", err).Errorf("gateway error")
+	}
+	if discordLog.Enabled(logging.Debug) {
+		wsutil.WSDebug = func(v ...interface{}) {
+			discordLog.Debugf("%s",
+				strings.TrimSuffix(fmt.Sprintln(v...), "\n"))
+		}
+	}
+
+	renderOptions := render.DefaultOptions()
+	if err := cfg.Render.Apply(renderOptions); err != nil {
+		fatal(logger, err)
 	}
 
-	var ln net.Listener
-	if !tlsEnabled {
+	clientConfig := &client.Config{
+		ServerName:    cfg.ServerName,
+		ServerVersion: cfg.ServerVersion,
+		MOTD:          cfg.MOTD,
+		Render:        renderOptions,
+		Uploads: &client.UploadOptions{
+			Dir:     cfg.UploadDir,
+			MaxSize: cfg.UploadLimit,
+			HTTP:    cfg.UploadHTTP,
+		},
+		PassLogin: cfg.PassLogin,
+		Backlog:   cfg.Backlog,
+		Users:     make(map[discord.Snowflake]*client.UserConfig),
+	}
+
+	if cfg.Accounts != "" {
+		store, err := openAccounts(cfg)
+		if err != nil {
+			fatal(logger, err)
+		}
+		clientConfig.Accounts = store
+	}
+
+	for id, user := range cfg.Users {
+		opts := *renderOptions
+		if err := user.Render.Apply(&opts); err != nil {
+			fatal(logger, err)
+		}
+		userConfig := &client.UserConfig{
+			Render:  &opts,
+			Backlog: cfg.Backlog,
+		}
+		if user.Backlog != nil {
+			userConfig.Backlog = *user.Backlog
+		}
+		clientConfig.Users[id] = userConfig
+	}
+
+	var certs *certStore
+	var tlsConfig *tls.Config
+	if cfg.TLS != nil {
 		var err error
-		ln, err = listen(port)
+		certs, err = newCertStore(cfg.TLS.Cert, cfg.TLS.Key,
+			root.Subsystem("tls"))
 		if err != nil {
-			log.Fatalln(err)
+			fatal(logger, err)
 		}
-	} else {
+		tlsConfig = certs.tlsConfig()
+
+		go certs.watch(certInterval, nil)
+	}
+
+	var listeners []net.Listener
+	for _, l := range cfg.Listeners {
+		ln, err := listen(l, tlsConfig)
+		if err != nil {
+			fatal(logger, err)
+		}
+		listeners = append(listeners, ln)
+	}
+
+	server := server.New(listeners, clientConfig, root)
+
+	var metricsListener net.Listener
+	if cfg.Metrics != "" {
 		var err error
-		ln, err = listenTLS(port, certfile, keyfile)
+		metricsListener, err = net.Listen("tcp", cfg.Metrics)
 		if err != nil {
-			log.Fatalln(err)
+			fatal(logger, errors.Wrap(err,
+				"failed to create metrics listener"))
 		}
+		metrics.Register(server)
 	}
 
-	server := server.New(ln, debug, ircDebug, discordDebug)
-	defer server.Close()
+	var adminListener net.Listener
+	if cfg.Admin != nil {
+		var err error
+		adminListener, err = listen(cfg.Admin, nil)
+		if err != nil {
+			fatal(logger, errors.Wrap(err,
+				"failed to create admin API listener"))
+		}
+	}
 
-	errors := make(chan error)
+	errors := make(chan error, 1)
 
 	go func() {
 		if err := server.Run(); err != nil {
@@ -111,15 +409,75 @@ func main() {
 		}
 	}()
 
-	log.Printf("listening on %v", ln.Addr())
+	for _, l := range cfg.Listeners {
+		logger.With("listener", l).Infof("listening")
+	}
+
+	if metricsListener != nil {
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", metrics.Handler())
+		go func() {
+			errors <- http.Serve(metricsListener, mux)
+		}()
+		logger.With("addr", metricsListener.Addr()).
+			Infof("serving metrics")
+	}
+
+	if adminListener != nil {
+		handler := admin.Handler(server, root.Subsystem("admin"))
+		go func() {
+			errors <- http.Serve(adminListener, handler)
+		}()
+		logger.With("addr", adminListener.Addr()).
+			Infof("serving the admin API")
+	}
 
 	sigch := make(chan os.Signal, 1)
-	signal.Notify(sigch, os.Interrupt)
+	signal.Notify(sigch, os.Interrupt, syscall.SIGTERM)
+
+	hupch := make(chan os.Signal, 1)
+	signal.Notify(hupch, syscall.SIGHUP)
+
+	os.Exit(wait(logger, server, certs, errors, sigch, hupch))
+}
+
+// fatal logs err and exits.
+func fatal(log *logging.Logger, err error) {
+	log.Errorf("%v", err)
+	os.Exit(1)
+}
 
-	select {
-	case err := <-errors:
-		log.Println(err)
-	case sig := <-sigch:
-		log.Printf("received signal '%v'", sig)
+// shutdownTimeout is how long clients are given to finish uploads when the
+// server is shutting down.
+const shutdownTimeout = 10 * time.Second
+
+// wait runs until the server fails or a signal to stop is received, then
+// shuts the server down and returns the exit code.
+func wait(log *logging.Logger, server *server.Server, certs *certStore,
+	errors <-chan error, sigch, hupch <-chan os.Signal) int {
+	for {
+		select {
+		case err := <-errors:
+			log.Errorf("%v", err)
+			server.Close()
+			return 1
+		case sig := <-sigch:
+			log.Infof("received signal '%v', shutting down", sig)
+			if err := server.Shutdown("server shutting down",
+				shutdownTimeout); err != nil {
+				log.With("error", err).
+					Errorf("failed to shut down cleanly")
+				return 1
+			}
+			return 0
+		case <-hupch:
+			if certs == nil {
+				continue
+			}
+			if err := certs.reload(); err != nil {
+				certs.log.With("error", err).
+					Warnf("keeping the current certificate")
+			}
+		}
 	}
 }